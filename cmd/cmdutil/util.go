@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/luikyv/mock-insurer/internal/timeutil"
@@ -73,3 +74,35 @@ func EnvValue[T ~string](key, fallback T) T {
 func PointerOf[T any](value T) *T {
 	return &value
 }
+
+// EnvDuration retrieves an environment variable as a duration, e.g. 30s, or
+// returns a fallback value if not found. It panics on invalid values so
+// misconfigurations are caught at startup.
+func EnvDuration(key string, fallback time.Duration) time.Duration {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		panic(fmt.Sprintf("invalid duration for %s: %v", key, err))
+	}
+	return d
+}
+
+// EnvInt retrieves an environment variable as an integer or returns a fallback
+// value if not found. It panics on invalid values so misconfigurations are
+// caught at startup.
+func EnvInt(key string, fallback int) int {
+	value, exists := os.LookupEnv(key)
+	if !exists {
+		return fallback
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		panic(fmt.Sprintf("invalid integer for %s: %v", key, err))
+	}
+	return n
+}
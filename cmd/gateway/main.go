@@ -258,8 +258,10 @@ func main() {
 	// Mock Insurer backend can be accessed from the host machine for local development.
 	mbHandler := reverseProxyWithFallback("host.docker.internal:80", "insurer:80")
 	mux.HandleFunc("auth.mockinsurer.local/", mbHandler)
+	mux.HandleFunc("api.mockinsurer.local/", mbHandler)
 	mux.HandleFunc("matls-auth.mockinsurer.local/", mbHandler)
 	mux.HandleFunc("matls-api.mockinsurer.local/", mbHandler)
+	mux.HandleFunc("admin.mockinsurer.local/", reverseProxyWithFallback("host.docker.internal:8081", "insurer:8081"))
 
 	// Serve participant information over HTTP because the Conformance Suite
 	// does not accept self-signed certificates.
@@ -18,6 +18,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/keyring"
 )
 
 var (
@@ -50,6 +51,9 @@ func main() {
 	generateJWKS("org", orgSigningCert, orgSigningKey, *keysDir)
 
 	_, _ = generateSigningCert("op_signing", *softwareID, *orgID, caCert, caKey, *keysDir)
+	// The keyring has its own directory so it can be mounted without the
+	// other keys.
+	generateKeyring("op", filepath.Join(*keysDir, "op"))
 
 	generateTransportCert("directory_client_transport", *softwareID, *orgID, caCert, caKey, *keysDir)
 	_, _ = generateSigningCert("directory_client_signing", *softwareID, *orgID, caCert, caKey, *keysDir)
@@ -72,8 +76,10 @@ func generateServerCert(name, dir string) (*x509.Certificate, *rsa.PrivateKey) {
 		DNSNames: []string{
 			"app.mockinsurer.local",
 			"auth.mockinsurer.local",
+			"api.mockinsurer.local",
 			"matls-auth.mockinsurer.local",
 			"matls-api.mockinsurer.local",
+			"admin.mockinsurer.local",
 			"directory.local",
 			"keystore.local",
 			"keystore.sandbox.directory.opinbrasil.com.br",
@@ -300,6 +306,25 @@ func generateJWKS(
 	}
 }
 
+// generateKeyring generates the keys the authorization server signs and decrypts
+// with. They are rotated with cmd/keyrotator.
+func generateKeyring(name, dir string) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		log.Fatalf("Failed to create keyring directory: %v", err)
+	}
+
+	k, err := keyring.New()
+	if err != nil {
+		log.Fatalf("Failed to generate keyring: %v", err)
+	}
+
+	if err := k.Save(filepath.Join(dir, name+"_keys.json")); err != nil {
+		log.Fatalf("Failed to save keyring: %v", err)
+	}
+
+	log.Printf("Generated keyring for %s\n", name)
+}
+
 func generateEncryptionJWK() goidc.JSONWebKey {
 	key, err := rsa.GenerateKey(rand.Reader, 4096)
 	if err != nil {
@@ -0,0 +1,42 @@
+// Command keyrotator rotates the keys of the authorization server.
+//
+//	go run ./cmd/keyrotator --keys_file=./keys/op/op_keys.json --grace=24h
+//
+// New current signing and encryption keys are generated. The previous ones stay
+// published for the grace period so clients have time to refresh their cached
+// JWKS, and keys whose grace period is over are removed. Running servers reload
+// the file on their own.
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/keyring"
+)
+
+func main() {
+	keysFile := flag.String("keys_file", "", "Keyring file")
+	grace := flag.Duration("grace", 24*time.Hour, "How long the retiring keys remain published")
+	flag.Parse()
+
+	if *keysFile == "" {
+		log.Fatal("keys_file is required")
+	}
+
+	k, err := keyring.Load(*keysFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := k.Rotate(*grace); err != nil {
+		log.Fatal(err)
+	}
+
+	if err := k.Save(*keysFile); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Rotated keys, the current signing key is %s and the current encryption key is %s\n", k.SigningKeyID, k.EncryptionKeyID)
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/luikyv/mock-insurer/internal/user"
+	"gorm.io/gorm"
+)
+
+// seedLockedUser creates a user locked after too many failed logins, so the
+// lockout can be tested without locking the other users. Seeding again locks
+// it back.
+func seedLockedUser(ctx context.Context, db *gorm.DB) error {
+	now := timeutil.DateTimeNow()
+	lockedUser := &user.User{
+		ID:                  uuid.MustParse("9b3a5c1e-6f2d-4c8a-9e7b-2d4f6a8c0e13"),
+		Username:            "bloqueado@seguradoramodelo.com.br",
+		Name:                "Usuário Bloqueado",
+		CPF:                 "52998224725",
+		Description:         pointerOf("User locked after too many failed logins"),
+		FailedLoginAttempts: user.MaxFailedLoginAttempts,
+		LockedAt:            &now,
+		CrossOrg:            true,
+		UpdatedAt:           now,
+		OrgID:               OrgID,
+	}
+	if err := lockedUser.SetPassword(DefaultPassword); err != nil {
+		return err
+	}
+	if err := db.WithContext(ctx).Omit("CreatedAt").Save(lockedUser).Error; err != nil {
+		return fmt.Errorf("failed to create the locked user: %w", err)
+	}
+	return nil
+}
@@ -25,6 +25,9 @@ var (
 	DBMigrationsPath = cmdutil.EnvValue("DB_MIGRATIONS_PATH", "file://db/migrations")
 )
 
+// DefaultPassword is the password of the seeded users.
+const DefaultPassword = "P@ssword01"
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -88,6 +91,14 @@ func seedDatabase(ctx context.Context, db *gorm.DB) error {
 		return fmt.Errorf("failed to seed usuario1: %w", err)
 	}
 
+	if err := seedLockedUser(ctx, db); err != nil {
+		return fmt.Errorf("failed to seed the locked user: %w", err)
+	}
+
+	if err := seedOpenData(ctx, db); err != nil {
+		return fmt.Errorf("failed to seed open data: %w", err)
+	}
+
 	if Env == cmdutil.LocalEnvironment {
 		if err := seedOAuthClients(ctx, db); err != nil {
 			return fmt.Errorf("failed to create OAuth client: %w", err)
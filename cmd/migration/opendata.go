@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+func seedOpenData(ctx context.Context, db *gorm.DB) error {
+	products := []*opendata.Product{
+		{
+			ID:   uuid.MustParse("0b6a4c2e-6f1d-4f43-9a55-1c8f6a0d2e01"),
+			Type: opendata.ProductTypeAuto,
+			Code: "AUTO-001",
+			Data: opendata.ProductData{
+				Name: "Auto Completo",
+				Coverages: []opendata.Coverage{
+					{Type: "CASCO_COMPREENSIVA", Description: "Cobertura contra colisão, incêndio, roubo e furto do veículo."},
+					{Type: "RESPONSABILIDADE_CIVIL_FACULTATIVA_DE_VEICULOS_RCFV", Description: "Danos materiais e corporais causados a terceiros."},
+					{Type: "ACIDENTES_PESSOAIS_DE_PASSAGEIROS_APP", Description: "Morte e invalidez dos ocupantes do veículo."},
+				},
+				AssistanceServices: []string{"Guincho 24 horas", "Chaveiro", "Carro reserva por 7 dias"},
+				TargetAudiences:    []opendata.TargetAudience{opendata.TargetAudienceNaturalPerson, opendata.TargetAudienceLegalPerson},
+				SusepProcessNumber: "15414.900001/2024-01",
+				TermsURL:           "https://www.seguradoramodelo.com.br/produtos/auto-completo/condicoes-gerais",
+			},
+		},
+		{
+			ID:   uuid.MustParse("0b6a4c2e-6f1d-4f43-9a55-1c8f6a0d2e02"),
+			Type: opendata.ProductTypeAuto,
+			Code: "AUTO-002",
+			Data: opendata.ProductData{
+				Name: "Auto Terceiros",
+				Coverages: []opendata.Coverage{
+					{Type: "RESPONSABILIDADE_CIVIL_FACULTATIVA_DE_VEICULOS_RCFV", Description: "Danos materiais e corporais causados a terceiros."},
+				},
+				TargetAudiences:    []opendata.TargetAudience{opendata.TargetAudienceNaturalPerson},
+				SusepProcessNumber: "15414.900002/2024-02",
+				TermsURL:           "https://www.seguradoramodelo.com.br/produtos/auto-terceiros/condicoes-gerais",
+			},
+		},
+		{
+			ID:   uuid.MustParse("0b6a4c2e-6f1d-4f43-9a55-1c8f6a0d2e03"),
+			Type: opendata.ProductTypeHome,
+			Code: "RESIDENCIAL-001",
+			Data: opendata.ProductData{
+				Name: "Residencial Essencial",
+				Coverages: []opendata.Coverage{
+					{Type: "INCENDIO_QUEDA_DE_RAIO_E_EXPLOSAO", Description: "Danos ao imóvel e ao conteúdo causados por incêndio, raio ou explosão."},
+					{Type: "DANOS_ELETRICOS", Description: "Danos a equipamentos causados por variação de tensão."},
+					{Type: "ROUBO_E_FURTO_QUALIFICADO", Description: "Roubo e furto qualificado de bens do conteúdo."},
+				},
+				AssistanceServices: []string{"Encanador", "Eletricista", "Chaveiro"},
+				TargetAudiences:    []opendata.TargetAudience{opendata.TargetAudienceNaturalPerson},
+				SusepProcessNumber: "15414.900003/2024-03",
+				TermsURL:           "https://www.seguradoramodelo.com.br/produtos/residencial-essencial/condicoes-gerais",
+			},
+		},
+		{
+			ID:   uuid.MustParse("0b6a4c2e-6f1d-4f43-9a55-1c8f6a0d2e04"),
+			Type: opendata.ProductTypeLifePension,
+			Code: "PGBL-001",
+			Data: opendata.ProductData{
+				Name: "PGBL Modelo Renda Fixa",
+				Coverages: []opendata.Coverage{
+					{Type: "SOBREVIVENCIA", Description: "Acumulação de recursos com conversão em renda ao final do período."},
+				},
+				TargetAudiences:    []opendata.TargetAudience{opendata.TargetAudienceNaturalPerson},
+				SusepProcessNumber: "15414.900004/2024-04",
+				TermsURL:           "https://www.seguradoramodelo.com.br/produtos/pgbl-renda-fixa/regulamento",
+				MinimumAmount:      pointerOf("100.00"),
+				TermMonths:         pointerOf(60),
+			},
+		},
+		{
+			ID:   uuid.MustParse("0b6a4c2e-6f1d-4f43-9a55-1c8f6a0d2e05"),
+			Type: opendata.ProductTypeCapitalizationTitle,
+			Code: "CAP-001",
+			Data: opendata.ProductData{
+				Name: "Capitalização Modelo Tradicional",
+				Coverages: []opendata.Coverage{
+					{Type: "TRADICIONAL", Description: "Resgate integral do valor pago ao final da vigência e sorteios mensais."},
+				},
+				TargetAudiences:    []opendata.TargetAudience{opendata.TargetAudienceNaturalPerson, opendata.TargetAudienceLegalPerson},
+				SusepProcessNumber: "15414.900005/2024-05",
+				TermsURL:           "https://www.seguradoramodelo.com.br/produtos/capitalizacao-tradicional/condicoes-gerais",
+				MinimumAmount:      pointerOf("50.00"),
+				TermMonths:         pointerOf(12),
+			},
+		},
+	}
+	for _, product := range products {
+		product.UpdatedAt = timeutil.DateTimeNow()
+		if err := db.WithContext(ctx).Omit("CreatedAt").Save(product).Error; err != nil {
+			return fmt.Errorf("failed to save product %s: %w", product.Code, err)
+		}
+	}
+
+	businessHours := []opendata.Availability{
+		{Weekday: "SEGUNDA_FEIRA", OpeningTime: "09:00", ClosingTime: "18:00"},
+		{Weekday: "TERCA_FEIRA", OpeningTime: "09:00", ClosingTime: "18:00"},
+		{Weekday: "QUARTA_FEIRA", OpeningTime: "09:00", ClosingTime: "18:00"},
+		{Weekday: "QUINTA_FEIRA", OpeningTime: "09:00", ClosingTime: "18:00"},
+		{Weekday: "SEXTA_FEIRA", OpeningTime: "09:00", ClosingTime: "18:00"},
+	}
+	channels := []*opendata.Channel{
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d01"),
+			Type: opendata.ChannelTypeBranch,
+			Code: "0001",
+			Data: opendata.ChannelData{
+				Name: "Agência Central",
+				Address: &opendata.Address{
+					Address:            "Av Paulista, 1000",
+					DistrictName:       "Bela Vista",
+					TownName:           "São Paulo",
+					CountrySubDivision: "SP",
+					PostCode:           "01310100",
+				},
+				Phones:       []opendata.Phone{{CountryCallingCode: "55", AreaCode: pointerOf("11"), Number: "30001000"}},
+				Availability: businessHours,
+				Services:     []string{"COTACAO", "CONTRATACAO", "AVISO_SINISTRO"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d02"),
+			Type: opendata.ChannelTypeElectronic,
+			Code: "INTERNET-01",
+			Data: opendata.ChannelData{
+				Name:     "Portal do Cliente",
+				Category: pointerOf("INTERNET"),
+				URLs:     []string{"https://www.seguradoramodelo.com.br"},
+				Services: []string{"COTACAO", "CONTRATACAO", "SEGUNDA_VIA_BOLETO"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d03"),
+			Type: opendata.ChannelTypeElectronic,
+			Code: "MOBILE-01",
+			Data: opendata.ChannelData{
+				Name:     "Aplicativo Seguradora Modelo",
+				Category: pointerOf("MOBILE"),
+				URLs:     []string{"https://apps.apple.com/br/app/seguradora-modelo", "https://play.google.com/store/apps/details?id=br.com.seguradoramodelo"},
+				Services: []string{"AVISO_SINISTRO", "ASSISTENCIA_24H"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d04"),
+			Type: opendata.ChannelTypePhone,
+			Code: "SAC-01",
+			Data: opendata.ChannelData{
+				Name:         "SAC",
+				Category:     pointerOf("SAC"),
+				Phones:       []opendata.Phone{{CountryCallingCode: "55", Number: "08007001000"}},
+				Availability: businessHours,
+				Services:     []string{"RECLAMACAO", "CANCELAMENTO"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d05"),
+			Type: opendata.ChannelTypePhone,
+			Code: "OUVIDORIA-01",
+			Data: opendata.ChannelData{
+				Name:         "Ouvidoria",
+				Category:     pointerOf("OUVIDORIA"),
+				Phones:       []opendata.Phone{{CountryCallingCode: "55", Number: "08007002000"}},
+				Availability: businessHours,
+				Services:     []string{"RECLAMACAO"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d06"),
+			Type: opendata.ChannelTypeIntermediary,
+			Code: "CORRETOR-01",
+			Data: opendata.ChannelData{
+				Name:           "Corretora Modelo Ltda",
+				Category:       pointerOf("CORRETOR_DE_SEGUROS"),
+				DocumentNumber: pointerOf("12345678000190"),
+				Address: &opendata.Address{
+					Address:            "Rua Augusta, 500",
+					DistrictName:       "Consolação",
+					TownName:           "São Paulo",
+					CountrySubDivision: "SP",
+					PostCode:           "01304000",
+				},
+				Phones:       []opendata.Phone{{CountryCallingCode: "55", AreaCode: pointerOf("11"), Number: "30002000"}},
+				Email:        pointerOf("contato@corretoramodelo.com.br"),
+				URLs:         []string{"https://www.corretoramodelo.com.br"},
+				Availability: businessHours,
+				Services:     []string{"COTACAO", "CONTRATACAO"},
+			},
+		},
+		{
+			ID:   uuid.MustParse("7d3f1b9a-2c4e-4b8d-8f6a-5e9c0a1b3d07"),
+			Type: opendata.ChannelTypeReferencedNetwork,
+			Code: "OFICINA-01",
+			Data: opendata.ChannelData{
+				Name:           "Oficina Modelo",
+				Category:       pointerOf("OFICINA"),
+				DocumentNumber: pointerOf("98765432000110"),
+				Address: &opendata.Address{
+					Address:            "Av do Estado, 2000",
+					DistrictName:       "Cambuci",
+					TownName:           "São Paulo",
+					CountrySubDivision: "SP",
+					PostCode:           "01516000",
+				},
+				Phones:       []opendata.Phone{{CountryCallingCode: "55", AreaCode: pointerOf("11"), Number: "30003000"}},
+				Availability: businessHours,
+				Services:     []string{"REPARO_VEICULO"},
+			},
+		},
+	}
+	for _, channel := range channels {
+		channel.UpdatedAt = timeutil.DateTimeNow()
+		if err := db.WithContext(ctx).Omit("CreatedAt").Save(channel).Error; err != nil {
+			return fmt.Errorf("failed to save channel %s: %w", channel.Code, err)
+		}
+	}
+
+	return nil
+}
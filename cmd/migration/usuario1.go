@@ -33,6 +33,9 @@ func seedUsuario1(ctx context.Context, db *gorm.DB) error {
 		UpdatedAt: timeutil.DateTimeNow(),
 		OrgID:     OrgID,
 	}
+	if err := testUser.SetPassword(DefaultPassword); err != nil {
+		return err
+	}
 	if err := db.WithContext(ctx).Omit("CreatedAt").Save(testUser).Error; err != nil {
 		return fmt.Errorf("failed to create test user: %w", err)
 	}
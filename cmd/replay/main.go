@@ -0,0 +1,144 @@
+// Command replay sends the requests of a traffic recording to a target server
+// and reports where its responses differ from the recorded ones.
+//
+//	go run ./cmd/replay --file=traffic.jsonl --target=http://localhost:80 --header="Authorization: Bearer <token>"
+//
+// Credentials are redacted from recordings, so the headers needed to
+// authenticate against the target are informed with --header. The Host header
+// recorded is kept so requests are routed as they were originally.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/recording"
+)
+
+type headers []string
+
+func (h *headers) String() string {
+	return strings.Join(*h, ", ")
+}
+
+func (h *headers) Set(value string) error {
+	if _, _, ok := strings.Cut(value, ":"); !ok {
+		return fmt.Errorf("invalid header %q, the format is 'Name: value'", value)
+	}
+	*h = append(*h, value)
+	return nil
+}
+
+func main() {
+	file := flag.String("file", "", "Recording file")
+	target := flag.String("target", "http://localhost:80", "Base URL of the server the requests are replayed against")
+	ignore := flag.String("ignore", "requestDateTime,self,first,prev,next,last", "Comma separated JSON fields not compared")
+	var extraHeaders headers
+	flag.Var(&extraHeaders, "header", "Header set in every request replayed, e.g. 'Authorization: Bearer token'. Can be repeated")
+	flag.Parse()
+
+	if *file == "" {
+		log.Fatal("file is required")
+	}
+
+	exchanges, err := recording.Read(*file)
+	if err != nil {
+		log.Fatalf("Failed to read recording: %v", err)
+	}
+
+	client := &http.Client{
+		Timeout: 30 * time.Second,
+		// Redirects are compared as recorded instead of followed.
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	ignoredFields := strings.Split(*ignore, ",")
+
+	failed := 0
+	for i, exchange := range exchanges {
+		resp, duration, err := replay(client, *target, exchange, extraHeaders)
+		label := fmt.Sprintf("#%d %s %s", i+1, exchange.Request.Method, exchange.Request.Path)
+		if err != nil {
+			failed++
+			fmt.Printf("FAIL %s: %v\n", label, err)
+			continue
+		}
+
+		if exchange.Response.BodyTruncated || resp.BodyTruncated {
+			fmt.Printf("WARN %s: the body was truncated, so it may differ\n", label)
+		}
+		diffs := recording.Diff(exchange.Response, resp, ignoredFields)
+		if len(diffs) == 0 {
+			fmt.Printf("OK   %s (%dms, recorded %dms)\n", label, duration.Milliseconds(), exchange.Duration)
+			continue
+		}
+
+		failed++
+		fmt.Printf("DIFF %s (%dms, recorded %dms)\n", label, duration.Milliseconds(), exchange.Duration)
+		if exchange.InteractionID != "" {
+			fmt.Printf("     interaction id: %s\n", exchange.InteractionID)
+		}
+		for _, diff := range diffs {
+			fmt.Printf("     %s\n", diff)
+		}
+	}
+
+	fmt.Printf("%d exchanges replayed, %d differ\n", len(exchanges), failed)
+	if failed != 0 {
+		os.Exit(1)
+	}
+}
+
+func replay(client *http.Client, target string, exchange *recording.Exchange, extraHeaders headers) (recording.Response, time.Duration, error) {
+	url := strings.TrimSuffix(target, "/") + exchange.Request.Path
+	if exchange.Request.Query != "" {
+		url += "?" + exchange.Request.Query
+	}
+
+	req, err := http.NewRequest(exchange.Request.Method, url, bytes.NewBufferString(exchange.Request.Body))
+	if err != nil {
+		return recording.Response{}, 0, fmt.Errorf("could not create request: %w", err)
+	}
+	req.Header = exchange.Request.Header.Clone()
+	if req.Header == nil {
+		req.Header = http.Header{}
+	}
+	// The length is recomputed from the body sent.
+	req.Header.Del("Content-Length")
+	for _, header := range extraHeaders {
+		name, value, _ := strings.Cut(header, ":")
+		req.Header.Set(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+	req.Host = exchange.Request.Host
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	if err != nil {
+		return recording.Response{}, 0, fmt.Errorf("could not send request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, recording.MaxBodyBytes+1))
+	if err != nil {
+		return recording.Response{}, 0, fmt.Errorf("could not read response: %w", err)
+	}
+	duration := time.Since(start)
+
+	replayed := recording.Response{
+		StatusCode: resp.StatusCode,
+		Header:     resp.Header,
+		Body:       string(body),
+	}
+	if len(body) > recording.MaxBodyBytes {
+		replayed.Body, replayed.BodyTruncated = string(body[:recording.MaxBodyBytes]), true
+	}
+	return replayed, duration, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"crypto/tls"
 	"encoding/json"
@@ -9,33 +10,55 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
 	"runtime/debug"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 
 	"github.com/luikyv/mock-insurer/cmd/cmdutil"
 	"github.com/luikyv/mock-insurer/internal/acceptancebranchesabroad"
 	acceptancebranchesabroadapi "github.com/luikyv/mock-insurer/internal/api/acceptancebranchesabroad"
+	adminapi "github.com/luikyv/mock-insurer/internal/api/admin"
 	autoapi "github.com/luikyv/mock-insurer/internal/api/auto"
 	capitalizationtitleapi "github.com/luikyv/mock-insurer/internal/api/capitalizationtitle"
+	channelsapi "github.com/luikyv/mock-insurer/internal/api/channels"
 	consentapi "github.com/luikyv/mock-insurer/internal/api/consent"
+	contractapi "github.com/luikyv/mock-insurer/internal/api/contract"
 	customerapi "github.com/luikyv/mock-insurer/internal/api/customer"
+	developerapi "github.com/luikyv/mock-insurer/internal/api/developer"
+	discoveryapi "github.com/luikyv/mock-insurer/internal/api/discovery"
+	dynamicfieldapi "github.com/luikyv/mock-insurer/internal/api/dynamicfield"
 	financialassistanceapi "github.com/luikyv/mock-insurer/internal/api/financialassistance"
 	financialriskapi "github.com/luikyv/mock-insurer/internal/api/financialrisk"
 	housingapi "github.com/luikyv/mock-insurer/internal/api/housing"
 	lifepensionapi "github.com/luikyv/mock-insurer/internal/api/lifepension"
+	metricsapi "github.com/luikyv/mock-insurer/internal/api/metrics"
+	apimiddleware "github.com/luikyv/mock-insurer/internal/api/middleware"
 	oidcapi "github.com/luikyv/mock-insurer/internal/api/oidc"
+	opsapi "github.com/luikyv/mock-insurer/internal/api/ops"
 	patrimonialapi "github.com/luikyv/mock-insurer/internal/api/patrimonial"
+	productsservicesapi "github.com/luikyv/mock-insurer/internal/api/productsservices"
 	quoteautoapi "github.com/luikyv/mock-insurer/internal/api/quoteauto"
 	resourceapi "github.com/luikyv/mock-insurer/internal/api/resource"
+	"github.com/luikyv/mock-insurer/internal/audit"
 	"github.com/luikyv/mock-insurer/internal/auto"
 	"github.com/luikyv/mock-insurer/internal/client"
 	"github.com/luikyv/mock-insurer/internal/customer"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
 	"github.com/luikyv/mock-insurer/internal/financialrisk"
 	"github.com/luikyv/mock-insurer/internal/housing"
 	"github.com/luikyv/mock-insurer/internal/idempotency"
+	"github.com/luikyv/mock-insurer/internal/keyring"
 	"github.com/luikyv/mock-insurer/internal/lifepension"
+	"github.com/luikyv/mock-insurer/internal/metric"
+	"github.com/luikyv/mock-insurer/internal/opendata"
 	"github.com/luikyv/mock-insurer/internal/patrimonial"
+	"github.com/luikyv/mock-insurer/internal/quote"
 	quoteauto "github.com/luikyv/mock-insurer/internal/quote/auto"
+	"github.com/luikyv/mock-insurer/internal/recording"
 	"github.com/luikyv/mock-insurer/internal/resource"
 	"github.com/luikyv/mock-insurer/internal/webhook"
 
@@ -49,7 +72,17 @@ import (
 	"github.com/luikyv/mock-insurer/internal/oidc"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"github.com/luikyv/mock-insurer/internal/user"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
+	gormtracing "gorm.io/plugin/opentelemetry/tracing"
 )
 
 var (
@@ -60,6 +93,7 @@ var (
 	AuthHost                = "https://auth." + BaseDomain
 	AuthMTLSHost            = "https://matls-auth." + BaseDomain
 	APIMTLSHost             = "https://matls-api." + BaseDomain
+	APIHost                 = "https://api." + BaseDomain
 	KeyStoreHost            = cmdutil.EnvValue("KEYSTORE_HOST", "https://keystore.local")
 	SoftwareStatementIssuer = cmdutil.EnvValue("SS_ISSUER", "Open Insurance Brasil Sandbox SSA issuer")
 	Port                    = cmdutil.EnvValue("PORT", "80")
@@ -67,16 +101,80 @@ var (
 	// TransportCertPath and TransportKeyPath are the file paths used for mutual TLS connections.
 	TransportCertPath = cmdutil.EnvValue("TRANSPORT_CERT_PATH", "../../keys/server_transport.crt")
 	TransportKeyPath  = cmdutil.EnvValue("TRANSPORT_KEY_PATH", "../../keys/server_transport.key")
+	// OPKeysPath is the keyring file with the signing and encryption keys of the
+	// authorization server. It is generated by cmd/keymaker and rotated with
+	// cmd/keyrotator.
+	OPKeysPath = cmdutil.EnvValue("OP_KEYS_PATH", "../../keys/op/op_keys.json")
+	// FAPIProfile is the security profile enforced by the authorization server,
+	// either fapi1 or fapi2.
+	FAPIProfile = goidc.Profile(cmdutil.EnvValue("FAPI_PROFILE", string(goidc.ProfileFAPI1)))
+	// IntrospectionClientIDs is a comma separated list of the clients allowed
+	// to introspect tokens.
+	IntrospectionClientIDs = cmdutil.EnvValue("INTROSPECTION_CLIENT_IDS", "")
+	// OTPMailboxPath is an optional file the one-time passwords for LOA3 are
+	// appended to. When empty, they are shown on the authentication page.
+	OTPMailboxPath = cmdutil.EnvValue("OTP_MAILBOX_PATH", "")
+	// AdminPort is the port of the listener serving the admin API.
+	AdminPort = cmdutil.EnvValue("ADMIN_PORT", "8081")
+	// AdminToken is the bearer token required to access the admin API. It
+	// defaults to "admin" only in the local environment.
+	AdminToken = cmdutil.EnvValue("ADMIN_TOKEN", "")
+	// OpsPort is the port of the listener serving the Prometheus metrics and
+	// the health probes.
+	OpsPort = cmdutil.EnvValue("OPS_PORT", "9090")
+	// BrandName, CompanyName and CompanyCNPJ identify the insurer in the open data APIs.
+	BrandName   = cmdutil.EnvValue("BRAND_NAME", "Seguradora Modelo")
+	CompanyName = cmdutil.EnvValue("COMPANY_NAME", "Seguradora Modelo S.A.")
+	CompanyCNPJ = cmdutil.EnvValue("COMPANY_CNPJ", "45086338000178")
+	// QuoteScenariosPath is an optional JSON file with quote scenarios loaded at startup.
+	QuoteScenariosPath = cmdutil.EnvValue("QUOTE_SCENARIOS_PATH", "")
+
+	// Timeouts and limits applied to all the HTTP listeners.
+	ReadTimeout       = cmdutil.EnvDuration("HTTP_READ_TIMEOUT", 5*time.Second)
+	WriteTimeout      = cmdutil.EnvDuration("HTTP_WRITE_TIMEOUT", 10*time.Second)
+	IdleTimeout       = cmdutil.EnvDuration("HTTP_IDLE_TIMEOUT", 120*time.Second)
+	ReadHeaderTimeout = cmdutil.EnvDuration("HTTP_READ_HEADER_TIMEOUT", 2*time.Second)
+	MaxHeaderBytes    = cmdutil.EnvInt("HTTP_MAX_HEADER_BYTES", http.DefaultMaxHeaderBytes)
+	MaxBodyBytes      = int64(cmdutil.EnvInt("HTTP_MAX_BODY_BYTES", 1<<20))
+	// TracingExporter selects where the traces are sent: none, otlp or file.
+	// The OTLP exporter is configured with the standard OTEL_EXPORTER_OTLP_*
+	// variables.
+	TracingExporter = cmdutil.EnvValue("TRACING_EXPORTER", "none")
+	// TracingFilePath is the file where the file exporter appends the spans.
+	TracingFilePath = cmdutil.EnvValue("TRACING_FILE_PATH", "traces.jsonl")
+	// ShutdownTimeout is how long the server waits for in-flight requests and
+	// background work to finish after SIGTERM.
+	ShutdownTimeout = cmdutil.EnvDuration("SHUTDOWN_TIMEOUT", 30*time.Second)
+	// RecordingPath is the JSON Lines file where the traffic served is
+	// recorded. Recording is disabled when empty.
+	RecordingPath = cmdutil.EnvValue("RECORDING_PATH", "")
+	// ConsentExpiryInterval is how often expired consents are rejected.
+	ConsentExpiryInterval = cmdutil.EnvDuration("CONSENT_EXPIRY_INTERVAL", 1*time.Minute)
 )
 
 func main() {
-	ctx, cancel := context.WithCancel(context.Background())
-	defer cancel()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
 	slog.SetDefault(logger())
 	slog.Info("setting up mock insurer", "env", Env)
 	http.DefaultClient = httpClient()
 
+	if AdminToken == "" {
+		if Env != cmdutil.LocalEnvironment {
+			slog.Error("ADMIN_TOKEN is required outside the local environment")
+			os.Exit(1)
+		}
+		AdminToken = "admin"
+	}
+
+	// Tracing.
+	shutdownTracing, err := tracerProvider(ctx)
+	if err != nil {
+		slog.Error("failed to set up tracing", "error", err)
+		os.Exit(1)
+	}
+
 	// Database.
 	slog.Info("connecting to database")
 	db, err := cmdutil.DB(ctx, DBCredentials)
@@ -84,7 +182,16 @@ func main() {
 		slog.Error("failed connecting to database", "error", err)
 		os.Exit(1)
 	}
+	if err := db.Use(gormtracing.NewPlugin(gormtracing.WithoutMetrics(), gormtracing.WithoutQueryVariables())); err != nil {
+		slog.Error("failed to set up database tracing", "error", err)
+		os.Exit(1)
+	}
 	slog.Info("successfully connected to database")
+	sqlDB, err := db.DB()
+	if err != nil {
+		slog.Error("failed to get the database connection pool", "error", err)
+		os.Exit(1)
+	}
 
 	// Keys.
 	transportTLSCert, err := tls.LoadX509KeyPair(TransportCertPath, TransportKeyPath)
@@ -96,10 +203,10 @@ func main() {
 	// Services.
 	clientService := client.NewService(db)
 	idempotencyService := idempotency.NewService(db)
-	_ = webhook.NewService(clientService, mtlsHTTPClient(transportTLSCert))
+	webhookService := webhook.NewService(clientService, mtlsHTTPClient(transportTLSCert))
 	userService := user.NewService(db)
 	resourceService := resource.NewService(db)
-	consentService := consent.NewService(db, userService)
+	consentService := consent.NewService(db, userService, oidc.NewGrantSessionManager(db))
 	customerService := customer.NewService(db)
 	autoService := auto.NewService(db)
 	capitalizationTitleService := capitalizationtitle.NewService(db)
@@ -109,7 +216,25 @@ func main() {
 	housingService := housing.NewService(db)
 	lifePensionService := lifepension.NewService(db)
 	patrimonialService := patrimonial.NewService(db)
-	quoteAutoService := quoteauto.NewService(db)
+	dynamicFieldService := dynamicfield.NewService(db)
+	quoteAutoService := quoteauto.NewService(db, AuthHost+"/contract/quote-auto", autoService, dynamicFieldService)
+	quoteScenarioService := quote.NewScenarioService(db)
+	openDataService := opendata.NewService(db, opendata.Brand{
+		Name:        BrandName,
+		CompanyName: CompanyName,
+		CNPJ:        CompanyCNPJ,
+	})
+	discoveryService := discovery.NewService(db)
+	metricService := metric.NewService(db, consentService)
+	auditService := audit.NewService(db)
+
+	if QuoteScenariosPath != "" {
+		slog.Info("loading quote scenarios", "path", QuoteScenariosPath)
+		if err := loadQuoteScenarios(ctx, quoteScenarioService, QuoteScenariosPath); err != nil {
+			slog.Error("failed to load quote scenarios", "error", err)
+			os.Exit(1)
+		}
+	}
 
 	op, err := openidProvider(
 		db,
@@ -146,22 +271,216 @@ func main() {
 	lifepensionapi.NewServer(APIMTLSHost, lifePensionService, consentService, op).RegisterRoutes(mux)
 	patrimonialapi.NewServer(APIMTLSHost, patrimonialService, consentService, op).RegisterRoutes(mux)
 	quoteautoapi.NewServer(APIMTLSHost, quoteAutoService, idempotencyService, op).RegisterRoutes(mux)
+	dynamicfieldapi.NewServer(APIMTLSHost, dynamicFieldService, op).RegisterRoutes(mux)
+	developerapi.NewServer(auditService, consentService, op).RegisterRoutes(mux)
+	contractapi.NewServer(AuthHost, quoteAutoService, userService).RegisterRoutes(mux)
+	oidc.NewApp(
+		AuthHost,
+		op,
+		oidc.NewAuthnSessionManager(db),
+		userService,
+		consentService,
+		autoService,
+		capitalizationTitleService,
+		financialAssistanceService,
+		acceptanceAndBranchesAbroadService,
+		financialRiskService,
+		housingService,
+		lifePensionService,
+		patrimonialService,
+	).RegisterRoutes(mux)
+	channelsapi.NewServer(APIHost, openDataService).RegisterRoutes(mux)
+	productsservicesapi.NewServer(APIHost, openDataService).RegisterRoutes(mux)
+	discoveryapi.NewServer(APIHost, APIMTLSHost, discoveryService).RegisterRoutes(mux)
+	metricsapi.NewServer(APIHost, metricService).RegisterRoutes(mux)
 
-	handler := middleware(mux)
-	slog.Info("starting mock insurer")
+	opsServer := opsapi.NewServer(sqlDB)
+	var handler http.Handler = apimiddleware.Outage(discoveryService)(mux)
+	handler = apimiddleware.Route(mux, "")(handler)
+	if RecordingPath != "" {
+		recorder, err := recording.NewRecorder(RecordingPath)
+		if err != nil {
+			slog.Error("failed to open traffic recording", "error", err)
+			os.Exit(1)
+		}
+		defer recorder.Close()
+		handler = apimiddleware.Record(recorder)(handler)
+	}
+	handler = traced(middleware(metricService, auditService, opsServer)(handler))
 
-	httpServer := &http.Server{
-		Addr:              ":" + Port,
-		Handler:           handler,
-		ReadTimeout:       5 * time.Second,
-		WriteTimeout:      10 * time.Second,
-		IdleTimeout:       120 * time.Second,
-		ReadHeaderTimeout: 2 * time.Second,
+	go pruneMetrics(ctx, metricService)
+	go expireConsents(ctx, consentService, webhookService)
+	if err := quoteAutoService.ResumeAutomations(ctx); err != nil {
+		slog.Error("failed to resume quote automations", "error", err)
 	}
-	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		slog.Error("failed to start mock insurer", "error", err)
-		os.Exit(1)
+
+	opsHTTPServer := httpServer(OpsPort, opsServer.Handler())
+	go func() {
+		slog.Info("starting ops api", "port", OpsPort)
+		if err := opsHTTPServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to start ops api", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	adminHandler := adminapi.NewServer(AdminToken, quoteScenarioService, quoteAutoService, dynamicFieldService, openDataService, discoveryService, auditService, consentService, clientService, userService).Handler()
+	adminServer := httpServer(AdminPort, traced(middleware(metricService, auditService, opsServer)(adminHandler)))
+	go func() {
+		slog.Info("starting admin api", "port", AdminPort)
+		if err := adminServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to start admin api", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	mainServer := httpServer(Port, handler)
+	go func() {
+		slog.Info("starting mock insurer")
+		if err := mainServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			slog.Error("failed to start mock insurer", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	// Restore the default signal handling so a second signal kills the
+	// process right away.
+	stop()
+	slog.Info("shutting down mock insurer", "timeout", ShutdownTimeout)
+
+	// Report not ready so no new traffic is routed here while draining.
+	opsServer.Drain()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), ShutdownTimeout)
+	defer cancel()
+
+	// The HTTP servers are drained first so no new quote automations or
+	// webhook notifications start while the background work is stopped.
+	if err := mainServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to drain mock insurer", "error", err)
+	}
+	if err := adminServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to drain admin api", "error", err)
+	}
+	if err := quote.StopAutomations(shutdownCtx); err != nil {
+		slog.Error("quote automations did not finish, they will be resumed on the next start", "error", err)
+	}
+	if err := webhook.Stop(shutdownCtx); err != nil {
+		slog.Error("failed to stop webhook delivery", "error", err)
+	}
+	if err := opsHTTPServer.Shutdown(shutdownCtx); err != nil {
+		slog.Error("failed to drain ops api", "error", err)
+	}
+	// The metrics and audit calls of the drained requests are still being
+	// saved and need the database.
+	if err := stopRecords(shutdownCtx); err != nil {
+		slog.Error("failed to save request metrics and audit calls", "error", err)
+	}
+	if err := sqlDB.Close(); err != nil {
+		slog.Error("failed to close database", "error", err)
+	}
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		slog.Error("failed to flush traces", "error", err)
+	}
+
+	slog.Info("mock insurer stopped")
+}
+
+// tracerProvider sets up the global tracer provider and the W3C trace context
+// propagation. The function returned flushes the spans still buffered.
+func tracerProvider(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	closeExporter := func() error { return nil }
+	switch TracingExporter {
+	case "none":
+		return func(context.Context) error { return nil }, nil
+	case "otlp":
+		otlpExporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("could not create otlp exporter: %w", err)
+		}
+		exporter = otlpExporter
+	case "file":
+		file, err := os.OpenFile(TracingFilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			return nil, fmt.Errorf("could not open traces file: %w", err)
+		}
+		fileExporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			return nil, fmt.Errorf("could not create file exporter: %w", err)
+		}
+		exporter = fileExporter
+		closeExporter = file.Close
+	default:
+		return nil, fmt.Errorf("invalid tracing exporter %s", TracingExporter)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(sdkresource.NewSchemaless(attribute.String("service.name", "mock-insurer"))),
+	)
+	otel.SetTracerProvider(tp)
+	return func(ctx context.Context) error {
+		return errors.Join(tp.Shutdown(ctx), closeExporter())
+	}, nil
+}
+
+// traced records a span for every request, continuing the trace informed in
+// the traceparent header if any.
+func traced(handler http.Handler) http.Handler {
+	return otelhttp.NewHandler(handler, "http.server", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+		return r.Method + " " + metric.Endpoint(r.URL.Path)
+	}))
+}
+
+// httpServer creates a server listening on port with the configured timeouts
+// and limits.
+func httpServer(port string, handler http.Handler) *http.Server {
+	return &http.Server{
+		Addr:              ":" + port,
+		Handler:           http.MaxBytesHandler(handler, MaxBodyBytes),
+		ReadTimeout:       ReadTimeout,
+		WriteTimeout:      WriteTimeout,
+		IdleTimeout:       IdleTimeout,
+		ReadHeaderTimeout: ReadHeaderTimeout,
+		MaxHeaderBytes:    MaxHeaderBytes,
+	}
+}
+
+// loadQuoteScenarios saves the quote scenarios defined in the file at path.
+// Scenarios are identified by organization and name, so loading the same file
+// again replaces them.
+func loadQuoteScenarios(ctx context.Context, service quote.ScenarioService, path string) error {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("could not read quote scenarios file: %w", err)
+	}
+
+	var scenarios []struct {
+		OrgID string `json:"orgId"`
+		adminapi.QuoteScenario
 	}
+	if err := json.Unmarshal(file, &scenarios); err != nil {
+		return fmt.Errorf("could not parse quote scenarios file: %w", err)
+	}
+
+	for _, s := range scenarios {
+		orgID := s.OrgID
+		if orgID == "" {
+			orgID = OrgID
+		}
+		if err := service.Save(ctx, &quote.Scenario{
+			Name:     s.Name,
+			Priority: s.Priority,
+			Rule:     s.Rule,
+			Outcome:  s.Outcome,
+			OrgID:    orgID,
+		}); err != nil {
+			return fmt.Errorf("could not save quote scenario %s: %w", s.Name, err)
+		}
+	}
+	return nil
 }
 
 func logger() *slog.Logger {
@@ -205,9 +524,9 @@ func httpClient() *http.Client {
 		tlsConfig.InsecureSkipVerify = true
 	}
 	return &http.Client{
-		Transport: &http.Transport{
+		Transport: otelhttp.NewTransport(&http.Transport{
 			TLSClientConfig: tlsConfig,
-		},
+		}),
 	}
 }
 
@@ -221,9 +540,9 @@ func mtlsHTTPClient(cert tls.Certificate) *http.Client {
 		tlsConfig.InsecureSkipVerify = true
 	}
 	return &http.Client{
-		Transport: &http.Transport{
+		Transport: otelhttp.NewTransport(&http.Transport{
 			TLSClientConfig: tlsConfig,
-		},
+		}),
 	}
 }
 
@@ -257,48 +576,20 @@ func openidProvider(
 		patrimonial.Scope,
 		quoteauto.Scope,
 		quoteauto.ScopeLead,
-		goidc.NewScope("dynamic-fields"),
-	}
-
-	var jwks goidc.JSONWebKeySet
-	if err := json.Unmarshal([]byte(`{
-      "keys": [
-        {
-	  	  "kid": "signer",
-          "p": "5BKxIVlA8DKoAbXnyNr-M_nAAi63lUCrCki7ADrsifHgTspQydfdQVA8DcqS0JxaHGlWr-mCjrMSSd8x1WOWW8TNqf0NF9O3XZGuCG35xbLG8V72pIMPM5HWr91RTQ0w6FqYkRJsot2ZYK53rtsDSwqQPK7LbRZTaSs-MCB-6SE",
-          "kty": "RSA",
-          "q": "u1_MSt9DNMqgL1N24S5VHXYmNH8p1ZP70KqH4WmJuYQfbgqQ7sU0L7nkR_H_IHHZqL3bruYVNPcKaE7tmHH5sRkix_R_MudjynV2la03UCKoSvnUgb0dguW9xDHKaXyVhzi24OPjolhhu0RYOqzF2GSJ2yZ0Z1zjPNLksEhxC2c",
-          "d": "lWi6shKVV8-nggjqc8PmpWOmMIDvfOiYUWVinjwyDEueljRBFUqrc8Z_lNQraEfM9dQ-GfNycEM9wN581H6M80hoVepTROMSYPZfDE_mX6aE48OReo6hJQvB3tUAuSkdjQj9_Tc_TLQEott-L89IJsAqP7AQSS0WvzfJL4O-YtIiyYNqbgbRfVTfaGAMIUKlO_dEf8jsbigBAbGVT7LIcAf9UokUBKc7Kudl_xCMzbDdM03xJeC5Ml0peOmnnTGc2NdBHSyXITnkrOJlnQWz5ZzyiJ3Os9Zm34gWcdXDz8emS9AHftqv6c9FbmBq9jMNU4_tiIMdAo6-p-xizlJawQ",
-          "e": "AQAB",
-          "use": "sig",
-          "qi": "TsQgLFpb6TozodDm_zoUcoPY6sWlijhvHgFFKAjnB2ssCJ6lO7X3bnep_cUt-dtkV7eZnh5A0cUNVtNnP3ni9EkpxWwsSTrG_TZHmbHxaHXnF-G4s6lrYGWBXbgucALegEFpmuJUThxpLEbpPOUzsWTxI66nOd6T5Quwx7qGv30",
-          "dp": "0FrfJMckEwtD_qQOxqiBeDweFCBXqGs2liORaolqFC86quAa4_pnb8Z7xmGctCVSEQiOoBAkLHcdKw1Suk3LS7TD6hp6Pp00s69lnN_TQa-sHU-S5QGx_nup9GmsX0bAulQhcs6xHixxdSiNv9jm7kQNNtK8lsDBnJ9bpZ3aMuE",
-          "alg": "PS256",
-          "dq": "Z7QtrYLD_4PmBEt9kEPEd_ncS1HWJY8x39uCOQ_gWfz2KEFQ1dXvfDq2TdtyCNL6VJo_7B0Lv7S63eBRP_5U49-1kFWR0OqgIH3ClDS6WG_WFSkQpH22x6u_y8aC8L8zQxPwo6d9ZWzlKnA5JMBa_9klM1WlN1ABtLhEOgzeBCE",
-          "n": "pu8AVLEIfYppnbU0r2M1PNhCvYpGnVXbSXj-OxRX72e_us-pYg2KnkTOPTIm3vQ53GsVYb8ajktGxvjWNzBeI2-OPXhwhvBKG14m_EON8t3_6fiB6PKsoFU474LLHilOr4TwOUh_oYjv_-5Ej5x1Je6XMHnsKkDCCmO1tzKoGZnoFgXxov12dZ84U374q5zwLzngPk7BC2Q0G7wIFbwf1Xm5ECSHXFHT_17iaRhu2s5eQ6B1dgx9RJBXjN-cgqZQIeNptbqXH67I3LaM_JcbKfrpx7KbDWivvKrfeWTyBJuJ9t8WD7k_4lfbbb4HUMKM761MgiIMv7GAZ8sItqU3Rw"
-        },
-        {
-		  "kid": "encrypter",
-          "p": "_aSA0u5saMEl1hc9-Sglp9LDOeZcgs_Gw7Olxefs77bIjMQpFwrFsIWR4HH6K9nscTIAKNM9AVq30Y1TTB0idebzPbjECB90KgYa3hm2g4A6pHkaOuHs0RGTWbWavDUkQka-CSB8hE7sTNSrmDpG8FbLihuSzDFWCdLGsDqXeuk",
-          "kty": "RSA",
-          "q": "gvBSWfBZtjHBqhwxXdO5k9J0nNqPta-sBuKc7PNhODbr0UWNkHcailKWs3f0ViXaSRAEW-EB9Ty4plgMBjy-ycc4va1Rfg-6Pn_tnVYbB5-4nmHO8vAFZR4EP4MHipyizJfNPuSlawLNc71Eo5lAUWzPRpTBZ1XvQ9AZgx-wA2M",
-          "d": "et4yFr71HRMW2epVzYPNcNfqGJqTU7NsbVMCSH-ZDJ_ysPn5CgTmAK-NZh2hJvra4RCBgpOQiEYqEqX5jc3xPZyTUtCTJwRpgVNLnhylk031hy22qA2QqWRsWGLBxRvgP8gb9intIs6MkrIiPkO2t5o3J9OYpF7aO40mXH5CM2EJm-FxqGuKMVb_zWVqImmh3mqC2GlPBsiZLcHeFIbtGopsel07nngBBSmCOf7XAmtqYvZAGiJQkd1poI7p_c5n7x3aj1jPGShVLzfLBWqNipoZk0GfbY7qTlkY6dT2x098V_MSpSip9tkQ__whdHOlR5GE_HT0vlmhfwixZKaTEQ",
-          "e": "AQAB",
-          "use": "enc",
-          "qi": "eiD4hKfSwXUVN8q14yL2JK4rUt0heIZ93CHVtkonsA8VasPOI1E6D51WaFRHaJxgvn7CiY16h2qg9xjP1uMBNcuscSKRnyqAeGJuyPh576-FWxJlZSqh9PoSxj4eHQMCWmBBi7TL820hrgA2mhc0KLekCRT36-89-Va7G74N5A8",
-          "dp": "n1NJNLZd1MOXD8-Tt0HXvX6v8VvZurXnhiD_vbw84isv-PRzVy0GFycgBhuyaP8__a7J2NswE_y3QOOEcmhOsD79hkTcprmTT558HA2MzzeqHoyPxHMMPhvLMmvYIedDunoTf0ovzTCCUJS6oSniS7BJtJwzbx6CjDMhaau0YZk",
-          "alg": "RSA-OAEP",
-          "dq": "G1DXXTvu-ztWE47eHZzV0ijNewt9f4GueaE865G6bmfGulmwNrsiJkkkdzxHFNHAwA0_W4uNRQPt4YXsvEBf7OhKxgcqQQo26GL3xyL3cJe5hBETg0rfVUD10eob4Kbcr6Hbh4tblv92rPaHIzoNWO9CLo9J6azbxWHccKZjqdE",
-          "n": "gbulO7BqCAKwVy3ZqrR033OM1Mp-SqOViwD1manyHjhDSB5dPLL8AG9zdl8hoQwQO8TVR4Ske2oYLkr9zxtWROTYKvF6Ssp0W5Df-sE6lEnMRqPr0GNrIubA0i2I0-uuK26N-x2_KJZbrMviH8qAdQGKopJ1-9DTvgXbOZmzQDuP3s0V8BB7pSroOaBpE7wtKAr5akPElbw_XR7m5ocmbd2TIHu8kdLU4W60Aha7x427KaYhetbtVkkS3h6j7FP9Wm2iMSkneo2ZA0WP4N4jqv3wqA2c7d_IeQNWmUxFrIoApmhy4MoMMDXjmWM_7JwH1UK6RsaknAfT7C0YJjVDGw"
-        }
-      ]
-    }`), &jwks); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal openid provider jwks: %w", err)
-	}
-
-	op, err := provider.New(goidc.ProfileFAPI1, AuthHost, func(_ context.Context) (goidc.JSONWebKeySet, error) {
-		return jwks, nil
-	})
+		dynamicfield.Scope,
+	}
+
+	keys, err := keyring.Open(OPKeysPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load openid provider keys: %w", err)
+	}
+
+	profileOpts, err := profileOptions(FAPIProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := provider.New(FAPIProfile, AuthHost, keys.JWKS)
 	if err != nil {
 		return nil, err
 	}
@@ -310,21 +601,34 @@ func openidProvider(
 		provider.WithScopes(scopes...),
 		provider.WithTokenOptions(oidc.TokenOptionsFunc()),
 		provider.WithAuthorizationCodeGrant(),
-		provider.WithImplicitGrant(),
+		// Refresh token rotation is not enabled in the provider since it applies
+		// to all clients. The grant session manager rotates refresh tokens only
+		// for clients registered with refresh_token_rotation.
 		provider.WithRefreshTokenGrant(func(_ context.Context, _ *goidc.Client, _ goidc.GrantInfo) bool { return true }, 3600),
 		provider.WithClientCredentialsGrant(),
+		provider.WithCIBAGrant(
+			oidc.InitBackAuthFunc(op, userService, consentService),
+			oidc.ValidateBackAuthFunc(),
+			goidc.CIBATokenDeliveryModePoll,
+			goidc.CIBATokenDeliveryModePing,
+		),
+		// Users answer CIBA requests in the insurer app, so they get more time
+		// than the default minute.
+		provider.WithCIBALifetime(600),
+		provider.WithTokenRevocation(func(*goidc.Client) bool { return true }, goidc.ClientAuthnPrivateKeyJWT),
+		provider.WithTokenIntrospection(
+			oidc.IsClientAllowedTokenIntrospectionFunc(strings.Split(IntrospectionClientIDs, ",")),
+			goidc.ClientAuthnPrivateKeyJWT,
+		),
 		provider.WithTokenAuthnMethods(goidc.ClientAuthnPrivateKeyJWT),
 		provider.WithPrivateKeyJWTSignatureAlgs(goidc.PS256),
 		provider.WithMTLS(AuthMTLSHost, oidc.ClientCert),
-		provider.WithTLSCertTokenBindingRequired(),
-		provider.WithPAR(oidc.HandlePARSessionFunc(), 60),
 		provider.WithUnregisteredRedirectURIsForPAR(),
 		provider.WithJAR(goidc.PS256),
 		provider.WithJAREncryption(goidc.RSA_OAEP),
 		provider.WithJARContentEncryptionAlgs(goidc.A256GCM),
 		provider.WithJARM(goidc.PS256),
 		provider.WithIssuerResponseParameter(),
-		provider.WithPKCE(goidc.CodeChallengeMethodSHA256),
 		provider.WithACRs(oidc.ACROpenInsuranceLOA2, oidc.ACROpenInsuranceLOA3),
 		provider.WithUserInfoSignatureAlgs(goidc.PS256),
 		provider.WithUserInfoEncryption(goidc.RSA_OAEP),
@@ -335,6 +639,7 @@ func openidProvider(
 		provider.WithHandleGrantFunc(oidc.HandleGrantFunc(op, consentService)),
 		provider.WithPolicies(oidc.Policies(
 			AuthHost,
+			OTPMailboxPath,
 			userService,
 			consentService,
 			autoService,
@@ -348,11 +653,13 @@ func openidProvider(
 		)...),
 		provider.WithNotifyErrorFunc(oidc.LogError),
 		provider.WithDCR(oidc.DCRFunc(oidc.DCRConfig{
-			Scopes:       scopes,
-			KeyStoreHost: KeyStoreHost,
-			SSIssuer:     SoftwareStatementIssuer,
+			Scopes:        scopes,
+			KeyStoreHost:  KeyStoreHost,
+			SSIssuer:      SoftwareStatementIssuer,
+			ClientService: clientService,
 		}), nil),
 	}
+	opts = append(opts, profileOpts...)
 	if err := op.WithOptions(opts...); err != nil {
 		return nil, err
 	}
@@ -360,25 +667,211 @@ func openidProvider(
 	return op, nil
 }
 
-func middleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
-		ctx = context.WithValue(ctx, api.CtxKeyCorrelationID, uuid.NewString())
-		if fapiID := r.Header.Get("X-Fapi-Interaction-Id"); fapiID != "" {
-			ctx = context.WithValue(ctx, api.CtxKeyInteractionID, fapiID)
-		}
-		slog.InfoContext(ctx, "request received", "method", r.Method, "path", r.URL.Path)
+// profileOptions returns the options that differ between the FAPI 1.0 Advanced
+// and the FAPI 2.0 Security Profile.
+func profileOptions(profile goidc.Profile) ([]provider.Option, error) {
+	switch profile {
+	case goidc.ProfileFAPI1:
+		return []provider.Option{
+			provider.WithImplicitGrant(),
+			provider.WithPAR(oidc.HandlePARSessionFunc(), 60),
+			provider.WithPKCE(goidc.CodeChallengeMethodSHA256),
+			provider.WithTLSCertTokenBindingRequired(),
+		}, nil
+	case goidc.ProfileFAPI2:
+		return []provider.Option{
+			provider.WithPARRequired(oidc.HandlePARSessionFunc(), 60),
+			provider.WithPKCERequired(goidc.CodeChallengeMethodSHA256),
+			// Tokens must be sender constrained, either with DPoP or with the
+			// client certificate.
+			provider.WithDPoP(goidc.PS256, goidc.ES256),
+			provider.WithTLSCertTokenBinding(),
+			provider.WithTokenBindingRequired(),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported fapi profile %q", profile)
+	}
+}
 
-		start := timeutil.DateTimeNow()
-		defer func() {
-			if rec := recover(); rec != nil {
-				slog.Error("panic recovered", "error", rec, "stack", string(debug.Stack()))
-				api.WriteError(w, r, fmt.Errorf("internal error: %v", rec))
+func middleware(metricService metric.Service, auditService audit.Service, opsServer opsapi.Server) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			ctx = context.WithValue(ctx, api.CtxKeyCorrelationID, uuid.NewString())
+			if fapiID := r.Header.Get("X-Fapi-Interaction-Id"); fapiID != "" {
+				ctx = context.WithValue(ctx, api.CtxKeyInteractionID, fapiID)
+				trace.SpanFromContext(ctx).SetAttributes(attribute.String("fapi.interaction_id", fapiID))
 			}
-			slog.InfoContext(ctx, "request completed", slog.Duration("duration", time.Since(start.Time)))
-		}()
+			// The auth middleware identifies the call deep in the handler chain,
+			// so a holder is passed down to collect the token information.
+			call := &audit.Call{}
+			ctx = context.WithValue(ctx, api.CtxKeyAuditCall, call)
+			// Same for the route, which is only known once the request reaches
+			// the mux that serves it.
+			route := &api.Route{Pattern: api.RouteUnmatched}
+			ctx = context.WithValue(ctx, api.CtxKeyRoute, route)
+			slog.InfoContext(ctx, "request received", "method", r.Method, "path", r.URL.Path)
 
-		r = r.WithContext(ctx)
-		next.ServeHTTP(w, r)
-	})
+			start := timeutil.DateTimeNow()
+			recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+			defer func() {
+				if rec := recover(); rec != nil {
+					slog.Error("panic recovered", "error", rec, "stack", string(debug.Stack()))
+					api.WriteError(recorder, r, fmt.Errorf("internal error: %v", rec))
+				}
+				duration := time.Since(start.Time)
+				slog.InfoContext(ctx, "request completed", slog.Duration("duration", duration))
+				opsServer.ObserveRequest(route.Pattern, r.Method, recorder.status, duration)
+
+				// Only the Open Insurance APIs are reported by the admin metrics.
+				if strings.HasPrefix(r.URL.Path, "/open-insurance/") {
+					record(func() {
+						if err := metricService.Record(context.WithoutCancel(ctx), &metric.Request{
+							Endpoint:     metric.Endpoint(r.URL.Path),
+							Method:       r.Method,
+							StatusCode:   recorder.status,
+							ResponseTime: duration.Milliseconds(),
+						}); err != nil {
+							slog.ErrorContext(ctx, "could not record request metric", "error", err)
+						}
+					})
+				}
+
+				if call.IsAuthenticated() {
+					call.Method = r.Method
+					call.Endpoint = metric.Endpoint(r.URL.Path)
+					call.Path = r.URL.Path
+					call.StatusCode = recorder.status
+					call.InteractionID = r.Header.Get("X-Fapi-Interaction-Id")
+					call.ErrorCode = audit.ErrorCode(recorder.errBody.Bytes())
+					call.Latency = duration.Milliseconds()
+					record(func() {
+						if err := auditService.Record(context.WithoutCancel(ctx), call); err != nil {
+							slog.ErrorContext(ctx, "could not record audit call", "error", err)
+						}
+					})
+				}
+			}()
+
+			r = r.WithContext(ctx)
+			next.ServeHTTP(recorder, r)
+		})
+	}
+}
+
+var (
+	// records waits for the request metrics and audit calls being saved in the
+	// background.
+	records sync.WaitGroup
+	// recordsStopped is guarded by recordsMu so no record starts while
+	// stopRecords waits.
+	recordsStopped bool
+	recordsMu      sync.Mutex
+)
+
+// record saves a request metric or audit call in the background so the
+// response isn't delayed. Records started once the server is stopping are
+// dropped.
+func record(save func()) {
+	recordsMu.Lock()
+	defer recordsMu.Unlock()
+
+	if recordsStopped {
+		return
+	}
+	records.Add(1)
+	go func() {
+		defer records.Done()
+		save()
+	}()
+}
+
+// stopRecords prevents new records from being saved and waits for the ones
+// being saved.
+func stopRecords(ctx context.Context) error {
+	recordsMu.Lock()
+	recordsStopped = true
+	recordsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		records.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop recording requests: %w", ctx.Err())
+	}
+}
+
+// maxErrBodyBytes limits how much of an error response is kept to extract its
+// error code.
+const maxErrBodyBytes = 4096
+
+// statusRecorder captures the status code written to the response and the
+// beginning of the body of error responses.
+type statusRecorder struct {
+	http.ResponseWriter
+	status  int
+	errBody bytes.Buffer
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *statusRecorder) Write(b []byte) (int, error) {
+	if r.status >= http.StatusBadRequest && r.errBody.Len() < maxErrBodyBytes {
+		r.errBody.Write(b[:min(len(b), maxErrBodyBytes-r.errBody.Len())])
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+// expireConsents periodically rejects the expired consents and notifies their
+// clients.
+func expireConsents(ctx context.Context, consentService consent.Service, webhookService webhook.Service) {
+	ticker := time.NewTicker(ConsentExpiryInterval)
+	defer ticker.Stop()
+	for {
+		consents, err := consentService.Expire(ctx)
+		if err != nil {
+			slog.ErrorContext(ctx, "could not expire consents", "error", err)
+		}
+		if len(consents) != 0 {
+			slog.InfoContext(ctx, "consents expired", "count", len(consents))
+		}
+		for _, c := range consents {
+			go webhookService.NotifyConsent(ctx, c.ClientID, c.URN(), "v2")
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// pruneMetrics periodically deletes the request metrics no longer reported.
+func pruneMetrics(ctx context.Context, metricService metric.Service) {
+	ticker := time.NewTicker(1 * time.Hour)
+	defer ticker.Stop()
+	for {
+		if err := metricService.Prune(ctx); err != nil {
+			slog.ErrorContext(ctx, "could not prune request metrics", "error", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
 }
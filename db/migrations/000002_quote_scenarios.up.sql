@@ -0,0 +1,14 @@
+-- quote_scenarios defines how quotes are evaluated by the quote automations.
+CREATE TABLE quote_scenarios (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    priority INTEGER NOT NULL DEFAULT 0,
+    rule JSONB NOT NULL,
+    outcome JSONB NOT NULL,
+
+    org_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_quote_scenarios_org_id ON quote_scenarios (org_id);
+CREATE UNIQUE INDEX idx_quote_scenarios_org_id_name ON quote_scenarios (org_id, name);
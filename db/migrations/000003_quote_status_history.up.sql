@@ -0,0 +1,13 @@
+-- quote_status_history keeps the status transitions of quote leads and quotes.
+CREATE TABLE quote_status_history (
+    id UUID PRIMARY KEY,
+    quote_id TEXT NOT NULL,
+    status TEXT NOT NULL,
+    reason TEXT,
+    forced BOOLEAN NOT NULL DEFAULT false,
+
+    org_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_quote_status_history_org_id ON quote_status_history (org_id);
+CREATE INDEX idx_quote_status_history_quote_id ON quote_status_history (quote_id);
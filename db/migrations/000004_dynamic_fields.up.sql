@@ -0,0 +1,15 @@
+-- dynamic_fields defines the custom data fields each organization accepts in
+-- the quote APIs. They are listed by the dynamic fields API.
+CREATE TABLE dynamic_fields (
+    id UUID PRIMARY KEY,
+    field_id TEXT NOT NULL,
+    catalogue TEXT NOT NULL,
+    api TEXT NOT NULL,
+    data JSONB NOT NULL,
+
+    org_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_dynamic_fields_org_id ON dynamic_fields (org_id);
+CREATE UNIQUE INDEX idx_dynamic_fields_org_id_catalogue_field_id ON dynamic_fields (org_id, catalogue, field_id);
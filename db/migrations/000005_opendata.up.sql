@@ -0,0 +1,25 @@
+-- opendata_products is the product catalogue published by the open data
+-- products and services API.
+CREATE TABLE opendata_products (
+    id UUID PRIMARY KEY,
+    type TEXT NOT NULL,
+    code TEXT NOT NULL,
+    data JSONB NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE UNIQUE INDEX idx_opendata_products_type_code ON opendata_products (type, code);
+
+-- opendata_channels are the service channels published by the open data
+-- channels API.
+CREATE TABLE opendata_channels (
+    id UUID PRIMARY KEY,
+    type TEXT NOT NULL,
+    code TEXT NOT NULL,
+    data JSONB NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE UNIQUE INDEX idx_opendata_channels_type_code ON opendata_channels (type, code);
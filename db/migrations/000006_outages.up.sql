@@ -0,0 +1,13 @@
+-- outages are scheduled unavailability windows published by the discovery
+-- API. Requests to the affected API families fail during the window.
+CREATE TABLE outages (
+    id UUID PRIMARY KEY,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    families JSONB NOT NULL DEFAULT '[]',
+    explanation TEXT NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_outages_ends_at ON outages (ends_at);
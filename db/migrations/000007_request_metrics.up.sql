@@ -0,0 +1,13 @@
+-- request_metrics holds one row per request served by the Open Insurance APIs.
+-- They're aggregated by the admin metrics API and pruned after the reported
+-- period.
+CREATE TABLE request_metrics (
+    id UUID PRIMARY KEY,
+    endpoint TEXT NOT NULL,
+    method TEXT NOT NULL,
+    status_code INTEGER NOT NULL,
+    response_time BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_request_metrics_created_at ON request_metrics (created_at);
@@ -0,0 +1,20 @@
+-- audit_calls records the resource API calls made with valid access tokens so
+-- developers can review the history of a consent or a client.
+CREATE TABLE audit_calls (
+    id UUID PRIMARY KEY,
+    consent_id TEXT NOT NULL DEFAULT '',
+    client_id TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    method TEXT NOT NULL,
+    endpoint TEXT NOT NULL,
+    path TEXT NOT NULL,
+    status_code INTEGER NOT NULL,
+    interaction_id TEXT NOT NULL DEFAULT '',
+    error_code TEXT NOT NULL DEFAULT '',
+    latency BIGINT NOT NULL,
+
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_audit_calls_consent_id ON audit_calls (consent_id, created_at);
+CREATE INDEX idx_audit_calls_client_id ON audit_calls (client_id, created_at);
+CREATE INDEX idx_audit_calls_org_id ON audit_calls (org_id, created_at);
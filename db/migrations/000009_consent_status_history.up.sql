@@ -0,0 +1,13 @@
+-- consent_status_history keeps the status transitions of consents along with
+-- who triggered them.
+CREATE TABLE consent_status_history (
+    id UUID PRIMARY KEY,
+    consent_id UUID NOT NULL,
+    status TEXT NOT NULL,
+    actor TEXT NOT NULL,
+    reason TEXT,
+
+    org_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_consent_status_history_consent_id ON consent_status_history (consent_id);
@@ -0,0 +1,9 @@
+-- consent_id links grants to the consent in their scopes so they can be revoked
+-- along with the consent.
+ALTER TABLE oauth_grants ADD COLUMN consent_id TEXT;
+CREATE INDEX idx_oauth_grants_consent_id ON oauth_grants (consent_id);
+
+-- Existing grants are linked to the consent in their scopes as well.
+UPDATE oauth_grants
+SET consent_id = substring(data->>'granted_scopes' FROM '(?:^| )consent:urn:mockinsurer:consent:([^ ]+)')
+WHERE data->>'granted_scopes' LIKE '%consent:urn:mockinsurer:consent:%';
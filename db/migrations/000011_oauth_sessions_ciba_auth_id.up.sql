@@ -0,0 +1,4 @@
+-- ciba_auth_id identifies the sessions created through CIBA so clients can poll
+-- them and users can answer them in the insurer app.
+ALTER TABLE oauth_sessions ADD COLUMN ciba_auth_id TEXT;
+CREATE INDEX idx_oauth_sessions_ciba_auth_id ON oauth_sessions (ciba_auth_id);
@@ -0,0 +1,12 @@
+-- oauth_client_history keeps the metadata of clients every time they are
+-- registered, updated or deleted through dynamic client registration.
+CREATE TABLE oauth_client_history (
+    id UUID PRIMARY KEY,
+    client_id TEXT NOT NULL,
+    action TEXT NOT NULL,
+    meta JSONB NOT NULL,
+
+    org_id TEXT NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now() NOT NULL
+);
+CREATE INDEX idx_oauth_client_history_client_id ON oauth_client_history (client_id);
@@ -0,0 +1,8 @@
+-- Users authenticate with their own password and are locked after too many
+-- failed attempts.
+ALTER TABLE mock_users ADD COLUMN password_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE mock_users ADD COLUMN failed_login_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE mock_users ADD COLUMN locked_at TIMESTAMPTZ;
+
+-- Existing users keep the password shared before, P@ssword01.
+UPDATE mock_users SET password_hash = '$2a$10$VpEiNvZeCyM97FA3U7kUxewsNNxLpRgRYEp5S1niREO9bRr4Fp86i' WHERE password_hash = '';
@@ -84,7 +84,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-acceptance-and-branches-abroad/{policyId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-acceptance-and-branches-abroad/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-acceptance-and-branches-abroad/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceAcceptanceAndBranchesAbroad(ctx context.Context, req GetInsuranceAcceptanceAndBranchesAbroadRequestObject) (GetInsuranceAcceptanceAndBranchesAbroadResponseObject, error) {
@@ -0,0 +1,200 @@
+// Package admin implements the internal API used to configure and inspect the
+// mock insurer. It is not part of the Open Insurance specification and is
+// served on its own listener.
+package admin
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/audit"
+	"github.com/luikyv/mock-insurer/internal/client"
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	quoteauto "github.com/luikyv/mock-insurer/internal/quote/auto"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/luikyv/mock-insurer/internal/user"
+)
+
+type Server struct {
+	token                string
+	quoteScenarioService quote.ScenarioService
+	quoteProducts        map[string]quoteProduct
+	dynamicFieldService  dynamicfield.Service
+	openDataService      opendata.Service
+	discoveryService     discovery.Service
+	auditService         audit.Service
+	consentService       consent.Service
+	clientService        client.Service
+	userService          user.Service
+}
+
+func NewServer(
+	token string,
+	quoteScenarioService quote.ScenarioService,
+	quoteAutoService quoteauto.Service,
+	dynamicFieldService dynamicfield.Service,
+	openDataService opendata.Service,
+	discoveryService discovery.Service,
+	auditService audit.Service,
+	consentService consent.Service,
+	clientService client.Service,
+	userService user.Service,
+) Server {
+	return Server{
+		token:                token,
+		quoteScenarioService: quoteScenarioService,
+		quoteProducts: map[string]quoteProduct{
+			quoteauto.API: quoteAutoProduct{service: quoteAutoService},
+		},
+		dynamicFieldService: dynamicFieldService,
+		openDataService:     openDataService,
+		discoveryService:    discoveryService,
+		auditService:        auditService,
+		consentService:      consentService,
+		clientService:       clientService,
+		userService:         userService,
+	}
+}
+
+func (s Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("GET /orgs/{orgId}/quote-scenarios", s.quoteScenariosHandler)
+	mux.HandleFunc("PUT /orgs/{orgId}/quote-scenarios/{name}", s.saveQuoteScenarioHandler)
+	mux.HandleFunc("DELETE /orgs/{orgId}/quote-scenarios/{name}", s.deleteQuoteScenarioHandler)
+
+	mux.HandleFunc("GET /orgs/{orgId}/leads", s.leadsHandler)
+	mux.HandleFunc("GET /orgs/{orgId}/leads/{product}/{id}", s.leadHandler)
+	mux.HandleFunc("POST /orgs/{orgId}/leads/{product}/{id}/status", s.forceLeadStatusHandler)
+	mux.HandleFunc("GET /orgs/{orgId}/quotes", s.quotesHandler)
+	mux.HandleFunc("GET /orgs/{orgId}/quotes/{product}/{id}", s.quoteHandler)
+	mux.HandleFunc("POST /orgs/{orgId}/quotes/{product}/{id}/status", s.forceQuoteStatusHandler)
+
+	mux.HandleFunc("GET /orgs/{orgId}/dynamic-fields/{catalogue}", s.dynamicFieldsHandler)
+	mux.HandleFunc("PUT /orgs/{orgId}/dynamic-fields/{catalogue}/{fieldId}", s.saveDynamicFieldHandler)
+	mux.HandleFunc("DELETE /orgs/{orgId}/dynamic-fields/{catalogue}/{fieldId}", s.deleteDynamicFieldHandler)
+
+	mux.HandleFunc("GET /opendata/products/{type}", s.openDataProductsHandler)
+	mux.HandleFunc("PUT /opendata/products/{type}/{code}", s.saveOpenDataProductHandler)
+	mux.HandleFunc("DELETE /opendata/products/{type}/{code}", s.deleteOpenDataProductHandler)
+	mux.HandleFunc("GET /opendata/channels/{type}", s.openDataChannelsHandler)
+	mux.HandleFunc("PUT /opendata/channels/{type}/{code}", s.saveOpenDataChannelHandler)
+	mux.HandleFunc("DELETE /opendata/channels/{type}/{code}", s.deleteOpenDataChannelHandler)
+
+	mux.HandleFunc("GET /outages", s.outagesHandler)
+	mux.HandleFunc("POST /outages", s.scheduleOutageHandler)
+	mux.HandleFunc("DELETE /outages/{id}", s.deleteOutageHandler)
+
+	mux.HandleFunc("GET /orgs/{orgId}/api-calls", s.apiCallsHandler)
+	mux.HandleFunc("GET /orgs/{orgId}/consents/{id}", s.consentHandler)
+	mux.HandleFunc("GET /orgs/{orgId}/clients/{id}/history", s.clientHistoryHandler)
+	mux.HandleFunc("POST /orgs/{orgId}/users/{id}/unlock", s.unlockUserHandler)
+
+	return s.authMiddleware(mux)
+}
+
+func (s Server) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			api.WriteError(w, r, api.NewError("UNAUTHORISED", http.StatusUnauthorized, "invalid admin token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+type QuoteScenario struct {
+	Name      string                `json:"name"`
+	Priority  int                   `json:"priority"`
+	Rule      quote.ScenarioRule    `json:"rule"`
+	Outcome   quote.ScenarioOutcome `json:"outcome"`
+	CreatedAt *timeutil.DateTime    `json:"createdAt,omitempty"`
+	UpdatedAt *timeutil.DateTime    `json:"updatedAt,omitempty"`
+}
+
+func (s Server) quoteScenariosHandler(w http.ResponseWriter, r *http.Request) {
+	scenarios, err := s.quoteScenarioService.Scenarios(r.Context(), r.PathValue("orgId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]QuoteScenario, 0, len(scenarios))
+	for _, scenario := range scenarios {
+		resp = append(resp, toQuoteScenario(scenario))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp}, http.StatusOK)
+}
+
+func (s Server) saveQuoteScenarioHandler(w http.ResponseWriter, r *http.Request) {
+	var req QuoteScenario
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	scenario := &quote.Scenario{
+		Name:     r.PathValue("name"),
+		Priority: req.Priority,
+		Rule:     req.Rule,
+		Outcome:  req.Outcome,
+		OrgID:    r.PathValue("orgId"),
+	}
+	if err := s.quoteScenarioService.Save(r.Context(), scenario); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": toQuoteScenario(scenario)}, http.StatusOK)
+}
+
+func (s Server) deleteQuoteScenarioHandler(w http.ResponseWriter, r *http.Request) {
+	if err := s.quoteScenarioService.Delete(r.Context(), r.PathValue("name"), r.PathValue("orgId")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toQuoteScenario(scenario *quote.Scenario) QuoteScenario {
+	return QuoteScenario{
+		Name:      scenario.Name,
+		Priority:  scenario.Priority,
+		Rule:      scenario.Rule,
+		Outcome:   scenario.Outcome,
+		CreatedAt: &scenario.CreatedAt,
+		UpdatedAt: &scenario.UpdatedAt,
+	}
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if errors.Is(err, quote.ErrScenarioNotFound) ||
+		errors.Is(err, quote.ErrNotFound) ||
+		errors.Is(err, dynamicfield.ErrNotFound) ||
+		errors.Is(err, opendata.ErrProductNotFound) ||
+		errors.Is(err, opendata.ErrChannelNotFound) ||
+		errors.Is(err, discovery.ErrNotFound) ||
+		errors.Is(err, consent.ErrNotFound) ||
+		errors.Is(err, client.ErrNotFound) ||
+		errors.Is(err, user.ErrNotFound) {
+		api.WriteError(w, r, api.NewError("NOT_FOUND", http.StatusNotFound, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
@@ -0,0 +1,63 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/audit"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+type APICall struct {
+	ID            string            `json:"id"`
+	ConsentID     string            `json:"consentId,omitempty"`
+	ClientID      string            `json:"clientId"`
+	Method        string            `json:"method"`
+	Endpoint      string            `json:"endpoint"`
+	Path          string            `json:"path"`
+	StatusCode    int               `json:"statusCode"`
+	InteractionID string            `json:"interactionId,omitempty"`
+	ErrorCode     string            `json:"errorCode,omitempty"`
+	Latency       int64             `json:"latency"`
+	CreatedAt     timeutil.DateTime `json:"createdAt"`
+}
+
+func (s Server) apiCallsHandler(w http.ResponseWriter, r *http.Request) {
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	query := audit.Query{
+		ConsentID: r.URL.Query().Get("consentId"),
+		ClientID:  r.URL.Query().Get("clientId"),
+	}
+	calls, err := s.auditService.Calls(r.Context(), query, r.PathValue("orgId"), pag)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]APICall, 0, len(calls.Records))
+	for _, call := range calls.Records {
+		resp = append(resp, toAPICall(call))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(calls)}, http.StatusOK)
+}
+
+func toAPICall(call *audit.Call) APICall {
+	return APICall{
+		ID:            call.ID.String(),
+		ConsentID:     call.ConsentID,
+		ClientID:      call.ClientID,
+		Method:        call.Method,
+		Endpoint:      call.Endpoint,
+		Path:          call.Path,
+		StatusCode:    call.StatusCode,
+		InteractionID: call.InteractionID,
+		ErrorCode:     call.ErrorCode,
+		Latency:       call.Latency,
+		CreatedAt:     call.CreatedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/client"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// ClientMetadataChange is the metadata of a client after it was registered,
+// updated or deleted.
+type ClientMetadataChange struct {
+	Action    client.Action     `json:"action"`
+	Metadata  goidc.ClientMeta  `json:"metadata"`
+	CreatedAt timeutil.DateTime `json:"createdAt"`
+}
+
+func (s Server) clientHistoryHandler(w http.ResponseWriter, r *http.Request) {
+	history, err := s.clientService.MetadataHistory(r.Context(), r.PathValue("id"), r.PathValue("orgId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	changes := make([]ClientMetadataChange, 0, len(history))
+	for _, h := range history {
+		changes = append(changes, ClientMetadataChange{
+			Action:    h.Action,
+			Metadata:  h.Meta,
+			CreatedAt: h.CreatedAt,
+		})
+	}
+	api.WriteJSON(w, map[string]any{"data": changes}, http.StatusOK)
+}
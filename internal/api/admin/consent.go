@@ -0,0 +1,71 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// ConsentRecord is a consent as stored by the mock insurer.
+type ConsentRecord struct {
+	ID              string                `json:"id"`
+	ClientID        string                `json:"clientId"`
+	Status          consent.Status        `json:"status"`
+	StatusUpdatedAt timeutil.DateTime     `json:"statusUpdatedAt"`
+	Permissions     consent.Permissions   `json:"permissions"`
+	ExpiresAt       timeutil.DateTime     `json:"expiresAt"`
+	Rejection       *consent.Rejection    `json:"rejection,omitempty"`
+	StatusHistory   []ConsentStatusChange `json:"statusHistory"`
+	CreatedAt       timeutil.DateTime     `json:"createdAt"`
+	UpdatedAt       timeutil.DateTime     `json:"updatedAt"`
+}
+
+type ConsentStatusChange struct {
+	Status    consent.Status    `json:"status"`
+	Actor     consent.Actor     `json:"actor"`
+	Reason    *string           `json:"reason,omitempty"`
+	CreatedAt timeutil.DateTime `json:"createdAt"`
+}
+
+func (s Server) consentHandler(w http.ResponseWriter, r *http.Request) {
+	id, orgID := r.PathValue("id"), r.PathValue("orgId")
+	c, err := s.consentService.Consent(r.Context(), id, orgID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	history, err := s.consentService.StatusHistory(r.Context(), id, orgID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": ConsentRecord{
+		ID:              c.URN(),
+		ClientID:        c.ClientID,
+		Status:          c.Status,
+		StatusUpdatedAt: c.StatusUpdatedAt,
+		Permissions:     c.Permissions,
+		ExpiresAt:       c.ExpiresAt,
+		Rejection:       c.Rejection,
+		StatusHistory:   toConsentStatusChanges(history),
+		CreatedAt:       c.CreatedAt,
+		UpdatedAt:       c.UpdatedAt,
+	}}, http.StatusOK)
+}
+
+func toConsentStatusChanges(history []*consent.StatusHistory) []ConsentStatusChange {
+	changes := make([]ConsentStatusChange, 0, len(history))
+	for _, h := range history {
+		changes = append(changes, ConsentStatusChange{
+			Status:    h.Status,
+			Actor:     h.Actor,
+			Reason:    h.Reason,
+			CreatedAt: h.CreatedAt,
+		})
+	}
+	return changes
+}
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+type DynamicField struct {
+	FieldID   string                 `json:"fieldId"`
+	Catalogue dynamicfield.Catalogue `json:"catalogue"`
+	API       string                 `json:"api"`
+	dynamicfield.Data
+	CreatedAt *timeutil.DateTime `json:"createdAt,omitempty"`
+	UpdatedAt *timeutil.DateTime `json:"updatedAt,omitempty"`
+}
+
+func (s Server) dynamicFieldsHandler(w http.ResponseWriter, r *http.Request) {
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	query := dynamicfield.Query{
+		Catalogue: dynamicfield.Catalogue(r.PathValue("catalogue")),
+		API:       r.URL.Query().Get("api"),
+	}
+	fields, err := s.dynamicFieldService.Fields(r.Context(), query, r.PathValue("orgId"), pag)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]DynamicField, 0, len(fields.Records))
+	for _, field := range fields.Records {
+		resp = append(resp, toDynamicField(field))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(fields)}, http.StatusOK)
+}
+
+func (s Server) saveDynamicFieldHandler(w http.ResponseWriter, r *http.Request) {
+	var req DynamicField
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	field := &dynamicfield.Field{
+		FieldID:   r.PathValue("fieldId"),
+		Catalogue: dynamicfield.Catalogue(r.PathValue("catalogue")),
+		API:       req.API,
+		Data:      req.Data,
+		OrgID:     r.PathValue("orgId"),
+	}
+	if err := s.dynamicFieldService.Save(r.Context(), field); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": toDynamicField(field)}, http.StatusOK)
+}
+
+func (s Server) deleteDynamicFieldHandler(w http.ResponseWriter, r *http.Request) {
+	catalogue := dynamicfield.Catalogue(r.PathValue("catalogue"))
+	if err := s.dynamicFieldService.Delete(r.Context(), catalogue, r.PathValue("fieldId"), r.PathValue("orgId")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toDynamicField(field *dynamicfield.Field) DynamicField {
+	return DynamicField{
+		FieldID:   field.FieldID,
+		Catalogue: field.Catalogue,
+		API:       field.API,
+		Data:      field.Data,
+		CreatedAt: &field.CreatedAt,
+		UpdatedAt: &field.UpdatedAt,
+	}
+}
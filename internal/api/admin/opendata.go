@@ -0,0 +1,144 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+type OpenDataProduct struct {
+	Type opendata.ProductType `json:"type"`
+	Code string               `json:"code"`
+	opendata.ProductData
+	CreatedAt *timeutil.DateTime `json:"createdAt,omitempty"`
+	UpdatedAt *timeutil.DateTime `json:"updatedAt,omitempty"`
+}
+
+type OpenDataChannel struct {
+	Type opendata.ChannelType `json:"type"`
+	Code string               `json:"code"`
+	opendata.ChannelData
+	CreatedAt *timeutil.DateTime `json:"createdAt,omitempty"`
+	UpdatedAt *timeutil.DateTime `json:"updatedAt,omitempty"`
+}
+
+func (s Server) openDataProductsHandler(w http.ResponseWriter, r *http.Request) {
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	products, err := s.openDataService.Products(r.Context(), opendata.ProductType(r.PathValue("type")), pag)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]OpenDataProduct, 0, len(products.Records))
+	for _, product := range products.Records {
+		resp = append(resp, toOpenDataProduct(product))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(products)}, http.StatusOK)
+}
+
+func (s Server) saveOpenDataProductHandler(w http.ResponseWriter, r *http.Request) {
+	var req OpenDataProduct
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	product := &opendata.Product{
+		Type: opendata.ProductType(r.PathValue("type")),
+		Code: r.PathValue("code"),
+		Data: req.ProductData,
+	}
+	if err := s.openDataService.SaveProduct(r.Context(), product); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": toOpenDataProduct(product)}, http.StatusOK)
+}
+
+func (s Server) deleteOpenDataProductHandler(w http.ResponseWriter, r *http.Request) {
+	productType := opendata.ProductType(r.PathValue("type"))
+	if err := s.openDataService.DeleteProduct(r.Context(), productType, r.PathValue("code")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s Server) openDataChannelsHandler(w http.ResponseWriter, r *http.Request) {
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	channels, err := s.openDataService.Channels(r.Context(), opendata.ChannelType(r.PathValue("type")), pag)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]OpenDataChannel, 0, len(channels.Records))
+	for _, channel := range channels.Records {
+		resp = append(resp, toOpenDataChannel(channel))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(channels)}, http.StatusOK)
+}
+
+func (s Server) saveOpenDataChannelHandler(w http.ResponseWriter, r *http.Request) {
+	var req OpenDataChannel
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	channel := &opendata.Channel{
+		Type: opendata.ChannelType(r.PathValue("type")),
+		Code: r.PathValue("code"),
+		Data: req.ChannelData,
+	}
+	if err := s.openDataService.SaveChannel(r.Context(), channel); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": toOpenDataChannel(channel)}, http.StatusOK)
+}
+
+func (s Server) deleteOpenDataChannelHandler(w http.ResponseWriter, r *http.Request) {
+	channelType := opendata.ChannelType(r.PathValue("type"))
+	if err := s.openDataService.DeleteChannel(r.Context(), channelType, r.PathValue("code")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toOpenDataProduct(product *opendata.Product) OpenDataProduct {
+	return OpenDataProduct{
+		Type:        product.Type,
+		Code:        product.Code,
+		ProductData: product.Data,
+		CreatedAt:   &product.CreatedAt,
+		UpdatedAt:   &product.UpdatedAt,
+	}
+}
+
+func toOpenDataChannel(channel *opendata.Channel) OpenDataChannel {
+	return OpenDataChannel{
+		Type:        channel.Type,
+		Code:        channel.Code,
+		ChannelData: channel.Data,
+		CreatedAt:   &channel.CreatedAt,
+		UpdatedAt:   &channel.UpdatedAt,
+	}
+}
@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+type Outage struct {
+	ID       string            `json:"id,omitempty"`
+	StartsAt timeutil.DateTime `json:"startsAt"`
+	EndsAt   timeutil.DateTime `json:"endsAt"`
+	// Families are the API families affected, e.g. consents. An empty list
+	// means all families.
+	Families    []discovery.Family `json:"families,omitempty"`
+	Explanation string             `json:"explanation"`
+	CreatedAt   *timeutil.DateTime `json:"createdAt,omitempty"`
+	UpdatedAt   *timeutil.DateTime `json:"updatedAt,omitempty"`
+}
+
+func (s Server) outagesHandler(w http.ResponseWriter, r *http.Request) {
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	outages, err := s.discoveryService.Outages(r.Context(), pag)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]Outage, 0, len(outages.Records))
+	for _, outage := range outages.Records {
+		resp = append(resp, toOutage(outage))
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(outages)}, http.StatusOK)
+}
+
+func (s Server) scheduleOutageHandler(w http.ResponseWriter, r *http.Request) {
+	var req Outage
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	outage := &discovery.Outage{
+		StartsAt:    req.StartsAt,
+		EndsAt:      req.EndsAt,
+		Families:    req.Families,
+		Explanation: req.Explanation,
+	}
+	if err := s.discoveryService.Schedule(r.Context(), outage); err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": toOutage(outage)}, http.StatusCreated)
+}
+
+func (s Server) deleteOutageHandler(w http.ResponseWriter, r *http.Request) {
+	if err := s.discoveryService.Delete(r.Context(), r.PathValue("id")); err != nil {
+		writeError(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func toOutage(outage *discovery.Outage) Outage {
+	return Outage{
+		ID:          outage.ID.String(),
+		StartsAt:    outage.StartsAt,
+		EndsAt:      outage.EndsAt,
+		Families:    outage.Families,
+		Explanation: outage.Explanation,
+		CreatedAt:   &outage.CreatedAt,
+		UpdatedAt:   &outage.UpdatedAt,
+	}
+}
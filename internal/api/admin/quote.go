@@ -0,0 +1,342 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	quoteauto "github.com/luikyv/mock-insurer/internal/quote/auto"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// QuoteRecord is a lead or quote as stored by the mock insurer.
+type QuoteRecord struct {
+	ID              string            `json:"id"`
+	Product         string            `json:"product"`
+	ConsentID       string            `json:"consentId"`
+	Status          quote.Status      `json:"status"`
+	StatusUpdatedAt timeutil.DateTime `json:"statusUpdatedAt"`
+	Data            any               `json:"data"`
+	StatusHistory   []StatusChange    `json:"statusHistory,omitempty"`
+	CreatedAt       timeutil.DateTime `json:"createdAt"`
+	UpdatedAt       timeutil.DateTime `json:"updatedAt"`
+}
+
+type StatusChange struct {
+	Status    quote.Status      `json:"status"`
+	Reason    *string           `json:"reason,omitempty"`
+	Forced    bool              `json:"forced"`
+	CreatedAt timeutil.DateTime `json:"createdAt"`
+}
+
+// quoteProduct gives access to the leads and quotes of a quote product.
+type quoteProduct interface {
+	leads(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[QuoteRecord], error)
+	lead(ctx context.Context, id, orgID string) (QuoteRecord, error)
+	forceLeadStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (QuoteRecord, error)
+	quotes(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[QuoteRecord], error)
+	quote(ctx context.Context, id, orgID string) (QuoteRecord, error)
+	forceQuoteStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (QuoteRecord, error)
+}
+
+func (s Server) leadsHandler(w http.ResponseWriter, r *http.Request) {
+	s.listQuoteRecords(w, r, quoteProduct.leads)
+}
+
+func (s Server) quotesHandler(w http.ResponseWriter, r *http.Request) {
+	s.listQuoteRecords(w, r, quoteProduct.quotes)
+}
+
+func (s Server) leadHandler(w http.ResponseWriter, r *http.Request) {
+	s.getQuoteRecord(w, r, quoteProduct.lead)
+}
+
+func (s Server) quoteHandler(w http.ResponseWriter, r *http.Request) {
+	s.getQuoteRecord(w, r, quoteProduct.quote)
+}
+
+func (s Server) forceLeadStatusHandler(w http.ResponseWriter, r *http.Request) {
+	s.forceQuoteRecordStatus(w, r, quoteProduct.forceLeadStatus)
+}
+
+func (s Server) forceQuoteStatusHandler(w http.ResponseWriter, r *http.Request) {
+	s.forceQuoteRecordStatus(w, r, quoteProduct.forceQuoteStatus)
+}
+
+// listQuoteRecords lists the leads or quotes of the product informed in the
+// "product" query parameter. When no product is informed, the records of all
+// products are listed and the pagination applies to each product.
+func (s Server) listQuoteRecords(
+	w http.ResponseWriter,
+	r *http.Request,
+	list func(quoteProduct, context.Context, quote.Filter, string, page.Pagination) (page.Page[QuoteRecord], error),
+) {
+	filter, err := quoteFilter(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	products := slices.Sorted(maps.Keys(s.quoteProducts))
+	if product := r.URL.Query().Get("product"); product != "" {
+		if _, ok := s.quoteProducts[product]; !ok {
+			writeError(w, r, errorutil.Format("invalid product %s", product))
+			return
+		}
+		products = []string{product}
+	}
+
+	pag, err := pagination(r)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	records := []QuoteRecord{}
+	meta := struct {
+		TotalRecords int `json:"totalRecords"`
+		TotalPages   int `json:"totalPages"`
+	}{}
+	for _, product := range products {
+		p, err := list(s.quoteProducts[product], r.Context(), filter, r.PathValue("orgId"), pag)
+		if err != nil {
+			writeError(w, r, err)
+			return
+		}
+		records = append(records, p.Records...)
+		meta.TotalRecords += p.TotalRecords
+		meta.TotalPages = max(meta.TotalPages, p.TotalPages)
+	}
+
+	api.WriteJSON(w, map[string]any{"data": records, "meta": meta}, http.StatusOK)
+}
+
+func (s Server) getQuoteRecord(
+	w http.ResponseWriter,
+	r *http.Request,
+	get func(quoteProduct, context.Context, string, string) (QuoteRecord, error),
+) {
+	product, ok := s.quoteProducts[r.PathValue("product")]
+	if !ok {
+		writeError(w, r, quote.ErrNotFound)
+		return
+	}
+
+	record, err := get(product, r.Context(), r.PathValue("id"), r.PathValue("orgId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": record}, http.StatusOK)
+}
+
+func (s Server) forceQuoteRecordStatus(
+	w http.ResponseWriter,
+	r *http.Request,
+	force func(quoteProduct, context.Context, string, string, quote.Status, string) (QuoteRecord, error),
+) {
+	product, ok := s.quoteProducts[r.PathValue("product")]
+	if !ok {
+		writeError(w, r, quote.ErrNotFound)
+		return
+	}
+
+	var req struct {
+		Status quote.Status `json:"status"`
+		Reason string       `json:"reason"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		return
+	}
+
+	record, err := force(product, r.Context(), r.PathValue("id"), r.PathValue("orgId"), req.Status, req.Reason)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": record}, http.StatusOK)
+}
+
+func quoteFilter(r *http.Request) (quote.Filter, error) {
+	query := r.URL.Query()
+	filter := quote.Filter{
+		Status:   quote.Status(query.Get("status")),
+		Document: query.Get("document"),
+	}
+
+	if from := query.Get("from"); from != "" {
+		t, err := parseDateTime(from, false)
+		if err != nil {
+			return quote.Filter{}, err
+		}
+		filter.From = &t
+	}
+
+	if to := query.Get("to"); to != "" {
+		t, err := parseDateTime(to, true)
+		if err != nil {
+			return quote.Filter{}, err
+		}
+		filter.To = &t
+	}
+
+	return filter, nil
+}
+
+// parseDateTime accepts both dates and date times. When endOfDay is true,
+// dates are considered until the end of the day.
+func parseDateTime(value string, endOfDay bool) (timeutil.DateTime, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return timeutil.NewDateTime(t), nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return timeutil.DateTime{}, errorutil.Format("invalid date %s", value)
+	}
+	if endOfDay {
+		return timeutil.NewDateTime(t).EndOfDay(), nil
+	}
+	return timeutil.NewDateTime(t), nil
+}
+
+func pagination(r *http.Request) (page.Pagination, error) {
+	var number, size *int32
+	for param, value := range map[string]**int32{"page": &number, "page-size": &size} {
+		raw := r.URL.Query().Get(param)
+		if raw == "" {
+			continue
+		}
+		parsed, err := strconv.ParseInt(raw, 10, 32)
+		if err != nil {
+			return page.Pagination{}, errorutil.Format("invalid %s", param)
+		}
+		n := int32(parsed)
+		*value = &n
+	}
+	return page.NewPagination(number, size), nil
+}
+
+type quoteAutoProduct struct {
+	service quoteauto.Service
+}
+
+func (p quoteAutoProduct) leads(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[QuoteRecord], error) {
+	leads, err := p.service.Leads(ctx, filter, orgID, pag)
+	if err != nil {
+		return page.Page[QuoteRecord]{}, err
+	}
+	return page.New(mapRecords(leads.Records, toQuoteAutoLeadRecord), pag, leads.TotalRecords), nil
+}
+
+func (p quoteAutoProduct) lead(ctx context.Context, id, orgID string) (QuoteRecord, error) {
+	lead, err := p.service.LeadByID(ctx, id, orgID)
+	if err != nil {
+		return QuoteRecord{}, err
+	}
+
+	history, err := p.service.LeadStatusHistory(ctx, id, orgID)
+	if err != nil {
+		return QuoteRecord{}, err
+	}
+
+	record := toQuoteAutoLeadRecord(lead)
+	record.StatusHistory = toStatusChanges(history)
+	return record, nil
+}
+
+func (p quoteAutoProduct) forceLeadStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (QuoteRecord, error) {
+	if _, err := p.service.ForceLeadStatus(ctx, id, orgID, status, reason); err != nil {
+		return QuoteRecord{}, err
+	}
+	return p.lead(ctx, id, orgID)
+}
+
+func (p quoteAutoProduct) quotes(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[QuoteRecord], error) {
+	quotes, err := p.service.Quotes(ctx, filter, orgID, pag)
+	if err != nil {
+		return page.Page[QuoteRecord]{}, err
+	}
+	return page.New(mapRecords(quotes.Records, toQuoteAutoRecord), pag, quotes.TotalRecords), nil
+}
+
+func (p quoteAutoProduct) quote(ctx context.Context, id, orgID string) (QuoteRecord, error) {
+	q, err := p.service.QuoteByID(ctx, id, orgID)
+	if err != nil {
+		return QuoteRecord{}, err
+	}
+
+	history, err := p.service.StatusHistory(ctx, id, orgID)
+	if err != nil {
+		return QuoteRecord{}, err
+	}
+
+	record := toQuoteAutoRecord(q)
+	record.StatusHistory = toStatusChanges(history)
+	return record, nil
+}
+
+func (p quoteAutoProduct) forceQuoteStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (QuoteRecord, error) {
+	if _, err := p.service.ForceStatus(ctx, id, orgID, status, reason); err != nil {
+		return QuoteRecord{}, err
+	}
+	return p.quote(ctx, id, orgID)
+}
+
+func toQuoteAutoLeadRecord(lead *quoteauto.Lead) QuoteRecord {
+	return QuoteRecord{
+		ID:              lead.ID.String(),
+		Product:         quoteauto.API,
+		ConsentID:       lead.ConsentID,
+		Status:          lead.Status,
+		StatusUpdatedAt: lead.StatusUpdatedAt,
+		Data:            lead.Data,
+		CreatedAt:       lead.CreatedAt,
+		UpdatedAt:       lead.UpdatedAt,
+	}
+}
+
+func toQuoteAutoRecord(q *quoteauto.Quote) QuoteRecord {
+	return QuoteRecord{
+		ID:              q.ID.String(),
+		Product:         quoteauto.API,
+		ConsentID:       q.ConsentID,
+		Status:          q.Status,
+		StatusUpdatedAt: q.StatusUpdatedAt,
+		Data:            q.Data,
+		CreatedAt:       q.CreatedAt,
+		UpdatedAt:       q.UpdatedAt,
+	}
+}
+
+func toStatusChanges(history []*quote.StatusHistory) []StatusChange {
+	changes := make([]StatusChange, 0, len(history))
+	for _, h := range history {
+		changes = append(changes, StatusChange{
+			Status:    h.Status,
+			Reason:    h.Reason,
+			Forced:    h.Forced,
+			CreatedAt: h.CreatedAt,
+		})
+	}
+	return changes
+}
+
+func mapRecords[T any](records []T, f func(T) QuoteRecord) []QuoteRecord {
+	mapped := make([]QuoteRecord, 0, len(records))
+	for _, r := range records {
+		mapped = append(mapped, f(r))
+	}
+	return mapped
+}
@@ -0,0 +1,31 @@
+package admin
+
+import (
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// UserLock is the lock state of a mock user.
+type UserLock struct {
+	ID                  string             `json:"id"`
+	Username            string             `json:"username"`
+	FailedLoginAttempts int                `json:"failedLoginAttempts"`
+	LockedAt            *timeutil.DateTime `json:"lockedAt,omitempty"`
+}
+
+func (s Server) unlockUserHandler(w http.ResponseWriter, r *http.Request) {
+	u, err := s.userService.Unlock(r.Context(), r.PathValue("id"), r.PathValue("orgId"))
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"data": UserLock{
+		ID:                  u.ID.String(),
+		Username:            u.Username,
+		FailedLoginAttempts: u.FailedLoginAttempts,
+		LockedAt:            u.LockedAt,
+	}}, http.StatusOK)
+}
@@ -83,7 +83,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-auto/{policyId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-auto/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-auto/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceAuto(ctx context.Context, req GetInsuranceAutoRequestObject) (GetInsuranceAutoResponseObject, error) {
@@ -84,7 +84,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-capitalization-title/{planId}/settlements", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-capitalization-title/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-capitalization-title/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceCapitalizationTitle(ctx context.Context, req GetInsuranceCapitalizationTitleRequestObject) (GetInsuranceCapitalizationTitleResponseObject, error) {
@@ -0,0 +1,29 @@
+package channels
+
+import (
+	"net/http"
+
+	v1 "github.com/luikyv/mock-insurer/internal/api/channels/v1"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+)
+
+type Server struct {
+	host    string
+	service opendata.Service
+}
+
+func NewServer(host string, service opendata.Service) Server {
+	return Server{
+		host:    host,
+		service: service,
+	}
+}
+
+func (s Server) RegisterRoutes(mux *http.ServeMux) {
+	muxV1, versionV1 := v1.NewServer(s.host, s.service).Handler()
+
+	mux.Handle("/open-insurance/channels/v1/", middleware.VersionRouting(map[string]http.Handler{
+		versionV1: muxV1,
+	}))
+}
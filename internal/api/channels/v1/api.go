@@ -0,0 +1,363 @@
+//go:generate go tool oapi-codegen -config=./config.yml -package=v1 -o=./api_gen.go ./swagger.yml
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+	"github.com/luikyv/mock-insurer/internal/page"
+)
+
+var _ StrictServerInterface = Server{}
+
+type Server struct {
+	baseURL string
+	service opendata.Service
+}
+
+func NewServer(host string, service opendata.Service) Server {
+	return Server{
+		baseURL: host + "/open-insurance/channels/v1",
+		service: service,
+	}
+}
+
+func (s Server) Handler() (http.Handler, string) {
+	mux := http.NewServeMux()
+
+	swaggerMiddleware, swaggerVersion := middleware.Swagger(GetSwagger, func(err error) api.Error {
+		return api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error())
+	})
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+			ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				writeResponseError(w, r, err)
+			},
+		}),
+		HandlerMiddlewares: []MiddlewareFunc{swaggerMiddleware},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		},
+	}
+
+	// Open data is public, so neither authentication nor the FAPI
+	// interaction ID is required.
+	mux.HandleFunc("GET /branches", wrapper.GetBranches)
+	mux.HandleFunc("GET /electronic-channels", wrapper.GetElectronicChannels)
+	mux.HandleFunc("GET /phone-channels", wrapper.GetPhoneChannels)
+	mux.HandleFunc("GET /intermediary", wrapper.GetIntermediary)
+	mux.HandleFunc("GET /referenced-network", wrapper.GetReferencedNetwork)
+
+	return middleware.StripPrefix("/open-insurance/channels/v1", mux, mux), swaggerVersion
+}
+
+func (s Server) GetBranches(ctx context.Context, req GetBranchesRequestObject) (GetBranchesResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	channels, err := s.service.Channels(ctx, opendata.ChannelTypeBranch, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := BranchCompany{
+		Name:       brand.CompanyName,
+		CnpjNumber: brand.CNPJ,
+		Branches:   []Branch{},
+	}
+	for _, c := range channels.Records {
+		branch := Branch{
+			Identification: BranchIdentification{
+				Type: FILIAL,
+				Code: c.Code,
+				Name: c.Data.Name,
+			},
+			Availability: toAvailability(c.Data.Availability),
+			Phones:       toPhones(c.Data.Phones),
+			Services:     toServices(c.Data.Services),
+		}
+		if c.Data.Category != nil {
+			branch.Identification.Type = BranchIdentificationType(*c.Data.Category)
+		}
+		if address := toPostalAddress(c.Data.Address); address != nil {
+			branch.PostalAddress = *address
+		}
+		company.Branches = append(company.Branches, branch)
+	}
+
+	resp := ResponseBranchList{
+		Data: BranchData{
+			Brand: BranchBrand{
+				Name:      brand.Name,
+				Companies: []BranchCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/branches", channels),
+		Meta:  *api.NewPaginatedMeta(channels),
+	}
+	return GetBranches200JSONResponse{OKResponseBranchListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetElectronicChannels(ctx context.Context, req GetElectronicChannelsRequestObject) (GetElectronicChannelsResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	channels, err := s.service.Channels(ctx, opendata.ChannelTypeElectronic, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := ElectronicChannelCompany{
+		Name:               brand.CompanyName,
+		CnpjNumber:         brand.CNPJ,
+		ElectronicChannels: []ElectronicChannel{},
+	}
+	for _, c := range channels.Records {
+		company.ElectronicChannels = append(company.ElectronicChannels, ElectronicChannel{
+			Identification: ElectronicChannelIdentification{
+				Name: &c.Data.Name,
+				Code: &c.Code,
+				Type: category(c),
+				Urls: c.Data.URLs,
+			},
+			Availability: toAvailability(c.Data.Availability),
+			Services:     toServices(c.Data.Services),
+		})
+	}
+
+	resp := ResponseElectronicChannelList{
+		Data: ElectronicChannelData{
+			Brand: ElectronicChannelBrand{
+				Name:      brand.Name,
+				Companies: []ElectronicChannelCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/electronic-channels", channels),
+		Meta:  *api.NewPaginatedMeta(channels),
+	}
+	return GetElectronicChannels200JSONResponse{OKResponseElectronicChannelListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetPhoneChannels(ctx context.Context, req GetPhoneChannelsRequestObject) (GetPhoneChannelsResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	channels, err := s.service.Channels(ctx, opendata.ChannelTypePhone, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := PhoneChannelCompany{
+		Name:          brand.CompanyName,
+		CnpjNumber:    brand.CNPJ,
+		PhoneChannels: []PhoneChannel{},
+	}
+	for _, c := range channels.Records {
+		channel := PhoneChannel{
+			Identification: PhoneChannelIdentification{
+				Name:   &c.Data.Name,
+				Code:   &c.Code,
+				Type:   category(c),
+				Phones: []Phone{},
+			},
+			Availability: toAvailability(c.Data.Availability),
+			Services:     toServices(c.Data.Services),
+		}
+		if phones := toPhones(c.Data.Phones); phones != nil {
+			channel.Identification.Phones = *phones
+		}
+		company.PhoneChannels = append(company.PhoneChannels, channel)
+	}
+
+	resp := ResponsePhoneChannelList{
+		Data: PhoneChannelData{
+			Brand: PhoneChannelBrand{
+				Name:      brand.Name,
+				Companies: []PhoneChannelCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/phone-channels", channels),
+		Meta:  *api.NewPaginatedMeta(channels),
+	}
+	return GetPhoneChannels200JSONResponse{OKResponsePhoneChannelListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetIntermediary(ctx context.Context, req GetIntermediaryRequestObject) (GetIntermediaryResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	channels, err := s.service.Channels(ctx, opendata.ChannelTypeIntermediary, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := IntermediaryCompany{
+		Name:           brand.CompanyName,
+		CnpjNumber:     brand.CNPJ,
+		Intermediaries: []Intermediary{},
+	}
+	for _, c := range channels.Records {
+		company.Intermediaries = append(company.Intermediaries, Intermediary{
+			Name:           c.Data.Name,
+			Code:           &c.Code,
+			DocumentNumber: documentNumber(c),
+			Type:           category(c),
+			PostalAddress:  toPostalAddress(c.Data.Address),
+			Access:         toAccess(c.Data),
+			Services:       toServices(c.Data.Services),
+		})
+	}
+
+	resp := ResponseIntermediaryList{
+		Data: IntermediaryData{
+			Brand: IntermediaryBrand{
+				Name:      brand.Name,
+				Companies: []IntermediaryCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/intermediary", channels),
+		Meta:  *api.NewPaginatedMeta(channels),
+	}
+	return GetIntermediary200JSONResponse{OKResponseIntermediaryListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetReferencedNetwork(ctx context.Context, req GetReferencedNetworkRequestObject) (GetReferencedNetworkResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	channels, err := s.service.Channels(ctx, opendata.ChannelTypeReferencedNetwork, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := ReferencedNetworkCompany{
+		Name:              brand.CompanyName,
+		CnpjNumber:        brand.CNPJ,
+		ReferencedNetwork: []ReferencedNetwork{},
+	}
+	for _, c := range channels.Records {
+		company.ReferencedNetwork = append(company.ReferencedNetwork, ReferencedNetwork{
+			Name:          c.Data.Name,
+			Code:          &c.Code,
+			CnpjNumber:    documentNumber(c),
+			Type:          category(c),
+			PostalAddress: toPostalAddress(c.Data.Address),
+			Access:        toAccess(c.Data),
+			Services:      toServices(c.Data.Services),
+		})
+	}
+
+	resp := ResponseReferencedNetworkList{
+		Data: ReferencedNetworkData{
+			Brand: ReferencedNetworkBrand{
+				Name:      brand.Name,
+				Companies: []ReferencedNetworkCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/referenced-network", channels),
+		Meta:  *api.NewPaginatedMeta(channels),
+	}
+	return GetReferencedNetwork200JSONResponse{OKResponseReferencedNetworkListJSONResponse(resp)}, nil
+}
+
+func category(c *opendata.Channel) string {
+	if c.Data.Category == nil {
+		return ""
+	}
+	return *c.Data.Category
+}
+
+func documentNumber(c *opendata.Channel) string {
+	if c.Data.DocumentNumber == nil {
+		return ""
+	}
+	return *c.Data.DocumentNumber
+}
+
+func toPostalAddress(address *opendata.Address) *PostalAddress {
+	if address == nil {
+		return nil
+	}
+
+	return &PostalAddress{
+		Address:            address.Address,
+		DistrictName:       address.DistrictName,
+		TownName:           address.TownName,
+		CountrySubDivision: address.CountrySubDivision,
+		PostCode:           address.PostCode,
+	}
+}
+
+// toAccess groups the contacts of intermediaries and of the referenced
+// network. The first url is informed as their site.
+func toAccess(data opendata.ChannelData) *Access {
+	access := &Access{
+		Email:  data.Email,
+		Phones: toPhones(data.Phones),
+	}
+	if len(data.URLs) != 0 {
+		access.Site = &data.URLs[0]
+	}
+	if availability := toAvailability(data.Availability); availability != nil {
+		access.Standards = &availability.Standards
+	}
+
+	if *access == (Access{}) {
+		return nil
+	}
+	return access
+}
+
+func toAvailability(availability []opendata.Availability) *Availability {
+	if len(availability) == 0 {
+		return nil
+	}
+
+	standards := make([]StandardAvailability, 0, len(availability))
+	for _, a := range availability {
+		standards = append(standards, StandardAvailability{
+			Weekday:     StandardAvailabilityWeekday(a.Weekday),
+			OpeningTime: a.OpeningTime,
+			ClosingTime: a.ClosingTime,
+		})
+	}
+	return &Availability{Standards: standards}
+}
+
+func toPhones(phones []opendata.Phone) *[]Phone {
+	if len(phones) == 0 {
+		return nil
+	}
+
+	result := make([]Phone, 0, len(phones))
+	for _, p := range phones {
+		result = append(result, Phone{
+			CountryCallingCode: p.CountryCallingCode,
+			AreaCode:           p.AreaCode,
+			Number:             p.Number,
+		})
+	}
+	return &result
+}
+
+func toServices(services []string) *Services {
+	if len(services) == 0 {
+		return nil
+	}
+
+	result := make(Services, 0, len(services))
+	for _, service := range services {
+		result = append(result, Service{Name: service})
+	}
+	return &result
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
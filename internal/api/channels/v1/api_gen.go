@@ -0,0 +1,2048 @@
+//go:build go1.22
+
+// Package v1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Defines values for BranchIdentificationType.
+const (
+	FILIAL                           BranchIdentificationType = "FILIAL"
+	POSTODEATENDIMENTO               BranchIdentificationType = "POSTO_DE_ATENDIMENTO"
+	SEDE                             BranchIdentificationType = "SEDE"
+	UNIDADEADMINISTRATIVADESMEMBRADA BranchIdentificationType = "UNIDADE_ADMINISTRATIVA_DESMEMBRADA"
+)
+
+// Defines values for PhoneType.
+const (
+	FIXO  PhoneType = "FIXO"
+	MOVEL PhoneType = "MOVEL"
+)
+
+// Defines values for StandardAvailabilityWeekday.
+const (
+	DOMINGO      StandardAvailabilityWeekday = "DOMINGO"
+	QUARTAFEIRA  StandardAvailabilityWeekday = "QUARTA_FEIRA"
+	QUINTAFEIRA  StandardAvailabilityWeekday = "QUINTA_FEIRA"
+	SABADO       StandardAvailabilityWeekday = "SABADO"
+	SEGUNDAFEIRA StandardAvailabilityWeekday = "SEGUNDA_FEIRA"
+	SEXTAFEIRA   StandardAvailabilityWeekday = "SEXTA_FEIRA"
+	TERCAFEIRA   StandardAvailabilityWeekday = "TERCA_FEIRA"
+)
+
+// Access Formas de acesso ao intermediário ou prestador de serviço.
+type Access struct {
+	// Email Endereço de e-mail.
+	Email *string `json:"email,omitempty"`
+
+	// Phones Telefones de contato.
+	Phones *[]Phone `json:"phones,omitempty"`
+
+	// Site Endereço do site.
+	Site *string `json:"site,omitempty"`
+
+	// Standards Horários de atendimento.
+	Standards *[]StandardAvailability `json:"standards,omitempty"`
+}
+
+// Availability defines model for Availability.
+type Availability struct {
+	// IsPublicAccessAllowed Indica se o acesso é aberto ao público.
+	IsPublicAccessAllowed *bool `json:"isPublicAccessAllowed,omitempty"`
+
+	// Standards Horários de funcionamento.
+	Standards []StandardAvailability `json:"standards"`
+}
+
+// Branch defines model for Branch.
+type Branch struct {
+	Availability   *Availability        `json:"availability,omitempty"`
+	Identification BranchIdentification `json:"identification"`
+
+	// Phones Telefones da dependência.
+	Phones        *[]Phone      `json:"phones,omitempty"`
+	PostalAddress PostalAddress `json:"postalAddress"`
+
+	// Services Serviços prestados.
+	Services *Services `json:"services,omitempty"`
+}
+
+// BranchBrand defines model for BranchBrand.
+type BranchBrand struct {
+	Companies []BranchCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// BranchCompany defines model for BranchCompany.
+type BranchCompany struct {
+	// Branches Lista de dependências próprias da sociedade.
+	Branches []Branch `json:"branches"`
+
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+}
+
+// BranchData defines model for BranchData.
+type BranchData struct {
+	Brand BranchBrand `json:"brand"`
+}
+
+// BranchIdentification defines model for BranchIdentification.
+type BranchIdentification struct {
+	// CheckDigit Dígito verificador do código da dependência.
+	CheckDigit *string `json:"checkDigit,omitempty"`
+
+	// Code Código identificador da dependência.
+	Code string `json:"code"`
+
+	// Name Nome da dependência.
+	Name string `json:"name"`
+
+	// Type Tipo da dependência.
+	Type BranchIdentificationType `json:"type"`
+}
+
+// BranchIdentificationType Tipo da dependência.
+type BranchIdentificationType string
+
+// ElectronicChannel defines model for ElectronicChannel.
+type ElectronicChannel struct {
+	Availability   *Availability                   `json:"availability,omitempty"`
+	Identification ElectronicChannelIdentification `json:"identification"`
+
+	// Services Serviços prestados.
+	Services *Services `json:"services,omitempty"`
+}
+
+// ElectronicChannelBrand defines model for ElectronicChannelBrand.
+type ElectronicChannelBrand struct {
+	Companies []ElectronicChannelCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// ElectronicChannelCompany defines model for ElectronicChannelCompany.
+type ElectronicChannelCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// ElectronicChannels Lista de canais eletrônicos da sociedade.
+	ElectronicChannels []ElectronicChannel `json:"electronicChannels"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+}
+
+// ElectronicChannelData defines model for ElectronicChannelData.
+type ElectronicChannelData struct {
+	Brand ElectronicChannelBrand `json:"brand"`
+}
+
+// ElectronicChannelIdentification defines model for ElectronicChannelIdentification.
+type ElectronicChannelIdentification struct {
+	// Code Código identificador do canal.
+	Code *string `json:"code,omitempty"`
+
+	// Name Nome do canal eletrônico.
+	Name *string `json:"name,omitempty"`
+
+	// Type Tipo de canal eletrônico, por exemplo INTERNET, MOBILE, CHAT ou WHATSAPP.
+	Type string `json:"type"`
+
+	// Urls URLs de acesso ao canal.
+	Urls []string `json:"urls"`
+}
+
+// GeographicCoordinates defines model for GeographicCoordinates.
+type GeographicCoordinates struct {
+	// Latitude Latitude em graus decimais.
+	Latitude *string `json:"latitude,omitempty"`
+
+	// Longitude Longitude em graus decimais.
+	Longitude *string `json:"longitude,omitempty"`
+}
+
+// Intermediary defines model for Intermediary.
+type Intermediary struct {
+	// Access Formas de acesso ao intermediário ou prestador de serviço.
+	Access *Access `json:"access,omitempty"`
+
+	// Code Código identificador do intermediário na sociedade.
+	Code *string `json:"code,omitempty"`
+
+	// DocumentNumber CPF ou CNPJ do intermediário.
+	DocumentNumber string `json:"documentNumber"`
+
+	// Name Nome ou razão social do intermediário.
+	Name          string         `json:"name"`
+	PostalAddress *PostalAddress `json:"postalAddress,omitempty"`
+
+	// Services Serviços prestados.
+	Services *Services `json:"services,omitempty"`
+
+	// Type Tipo de intermediário, por exemplo CORRETOR_DE_SEGUROS ou REPRESENTANTE_DE_SEGUROS.
+	Type string `json:"type"`
+}
+
+// IntermediaryBrand defines model for IntermediaryBrand.
+type IntermediaryBrand struct {
+	Companies []IntermediaryCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// IntermediaryCompany defines model for IntermediaryCompany.
+type IntermediaryCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Intermediaries Lista de intermediários da sociedade.
+	Intermediaries []Intermediary `json:"intermediaries"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+}
+
+// IntermediaryData defines model for IntermediaryData.
+type IntermediaryData struct {
+	Brand IntermediaryBrand `json:"brand"`
+}
+
+// Phone defines model for Phone.
+type Phone struct {
+	// AreaCode Código de discagem direta à distância.
+	AreaCode *string `json:"areaCode,omitempty"`
+
+	// CountryCallingCode Código de discagem internacional.
+	CountryCallingCode string `json:"countryCallingCode"`
+
+	// Number Número do telefone.
+	Number string `json:"number"`
+
+	// Type Tipo de telefone.
+	Type *PhoneType `json:"type,omitempty"`
+}
+
+// PhoneType Tipo de telefone.
+type PhoneType string
+
+// PhoneChannel defines model for PhoneChannel.
+type PhoneChannel struct {
+	Availability   *Availability              `json:"availability,omitempty"`
+	Identification PhoneChannelIdentification `json:"identification"`
+
+	// Services Serviços prestados.
+	Services *Services `json:"services,omitempty"`
+}
+
+// PhoneChannelBrand defines model for PhoneChannelBrand.
+type PhoneChannelBrand struct {
+	Companies []PhoneChannelCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// PhoneChannelCompany defines model for PhoneChannelCompany.
+type PhoneChannelCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// PhoneChannels Lista de canais telefônicos da sociedade.
+	PhoneChannels []PhoneChannel `json:"phoneChannels"`
+}
+
+// PhoneChannelData defines model for PhoneChannelData.
+type PhoneChannelData struct {
+	Brand PhoneChannelBrand `json:"brand"`
+}
+
+// PhoneChannelIdentification defines model for PhoneChannelIdentification.
+type PhoneChannelIdentification struct {
+	// Code Código identificador do canal.
+	Code *string `json:"code,omitempty"`
+
+	// Name Nome do canal telefônico.
+	Name *string `json:"name,omitempty"`
+
+	// Phones Telefones do canal.
+	Phones []Phone `json:"phones"`
+
+	// Type Tipo de canal telefônico, por exemplo CENTRAL_TELEFONICA, SAC ou OUVIDORIA.
+	Type string `json:"type"`
+}
+
+// PostalAddress defines model for PostalAddress.
+type PostalAddress struct {
+	// AdditionalInfo Complemento do endereço.
+	AdditionalInfo *string `json:"additionalInfo,omitempty"`
+
+	// Address Endereço completo.
+	Address string `json:"address"`
+
+	// Country Nome do país.
+	Country *string `json:"country,omitempty"`
+
+	// CountryCode Código do país de acordo com o código alpha3 do ISO-3166.
+	CountryCode *string `json:"countryCode,omitempty"`
+
+	// CountrySubDivision Sigla da unidade da federação.
+	CountrySubDivision string `json:"countrySubDivision"`
+
+	// DistrictName Bairro.
+	DistrictName          string                 `json:"districtName"`
+	GeographicCoordinates *GeographicCoordinates `json:"geographicCoordinates,omitempty"`
+
+	// IbgeCode Código IBGE do município.
+	IbgeCode *string `json:"ibgeCode,omitempty"`
+
+	// PostCode CEP.
+	PostCode string `json:"postCode"`
+
+	// TownName Município.
+	TownName string `json:"townName"`
+}
+
+// ReferencedNetwork defines model for ReferencedNetwork.
+type ReferencedNetwork struct {
+	// Access Formas de acesso ao intermediário ou prestador de serviço.
+	Access *Access `json:"access,omitempty"`
+
+	// CnpjNumber CNPJ do prestador de serviço.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Code Código identificador do prestador de serviço na sociedade.
+	Code *string `json:"code,omitempty"`
+
+	// Name Nome ou razão social do prestador de serviço.
+	Name          string         `json:"name"`
+	PostalAddress *PostalAddress `json:"postalAddress,omitempty"`
+
+	// Services Serviços prestados.
+	Services *Services `json:"services,omitempty"`
+
+	// Type Tipo de prestador de serviço, por exemplo OFICINA, GUINCHO ou CLINICA.
+	Type string `json:"type"`
+}
+
+// ReferencedNetworkBrand defines model for ReferencedNetworkBrand.
+type ReferencedNetworkBrand struct {
+	Companies []ReferencedNetworkCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// ReferencedNetworkCompany defines model for ReferencedNetworkCompany.
+type ReferencedNetworkCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// ReferencedNetwork Lista de prestadores de serviço da rede referenciada da sociedade.
+	ReferencedNetwork []ReferencedNetwork `json:"referencedNetwork"`
+}
+
+// ReferencedNetworkData defines model for ReferencedNetworkData.
+type ReferencedNetworkData struct {
+	Brand ReferencedNetworkBrand `json:"brand"`
+}
+
+// ResponseBranchList defines model for ResponseBranchList.
+type ResponseBranchList struct {
+	Data  BranchData `json:"data"`
+	Links api.Links  `json:"links"`
+	Meta  api.Meta   `json:"meta"`
+}
+
+// ResponseElectronicChannelList defines model for ResponseElectronicChannelList.
+type ResponseElectronicChannelList struct {
+	Data  ElectronicChannelData `json:"data"`
+	Links api.Links             `json:"links"`
+	Meta  api.Meta              `json:"meta"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Errors []struct {
+		// Code Código de erro específico do endpoint
+		Code string `json:"code"`
+
+		// Detail Descrição legível por humanos deste erro específico
+		Detail string `json:"detail"`
+
+		// RequestDateTime Data e hora da consulta, conforme especificação RFC-3339, formato UTC.
+		RequestDateTime timeutil.DateTime `json:"requestDateTime"`
+
+		// Title Título legível por humanos deste erro específico
+		Title string `json:"title"`
+	} `json:"errors"`
+	Meta *api.Meta `json:"meta,omitempty"`
+}
+
+// ResponseIntermediaryList defines model for ResponseIntermediaryList.
+type ResponseIntermediaryList struct {
+	Data  IntermediaryData `json:"data"`
+	Links api.Links        `json:"links"`
+	Meta  api.Meta         `json:"meta"`
+}
+
+// ResponsePhoneChannelList defines model for ResponsePhoneChannelList.
+type ResponsePhoneChannelList struct {
+	Data  PhoneChannelData `json:"data"`
+	Links api.Links        `json:"links"`
+	Meta  api.Meta         `json:"meta"`
+}
+
+// ResponseReferencedNetworkList defines model for ResponseReferencedNetworkList.
+type ResponseReferencedNetworkList struct {
+	Data  ReferencedNetworkData `json:"data"`
+	Links api.Links             `json:"links"`
+	Meta  api.Meta              `json:"meta"`
+}
+
+// Service defines model for Service.
+type Service struct {
+	// Name Nome do serviço, por exemplo COTACAO, CONTRATACAO ou AVISO_SINISTRO.
+	Name string `json:"name"`
+}
+
+// Services Serviços prestados.
+type Services = []Service
+
+// StandardAvailability defines model for StandardAvailability.
+type StandardAvailability struct {
+	// ClosingTime Horário de fechamento.
+	ClosingTime string `json:"closingTime"`
+
+	// OpeningTime Horário de abertura.
+	OpeningTime string `json:"openingTime"`
+
+	// Weekday Dia da semana.
+	Weekday StandardAvailabilityWeekday `json:"weekday"`
+}
+
+// StandardAvailabilityWeekday Dia da semana.
+type StandardAvailabilityWeekday string
+
+// Page defines model for page.
+type Page = int32
+
+// PageSize defines model for pageSize.
+type PageSize = int32
+
+// XMinV defines model for x-min-v.
+type XMinV = string
+
+// XVHeader defines model for x-v.
+type XVHeader = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Default defines model for Default.
+type Default = ResponseError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ResponseError
+
+// MethodNotAllowed defines model for MethodNotAllowed.
+type MethodNotAllowed = ResponseError
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// OKResponseBranchList defines model for OKResponseBranchList.
+type OKResponseBranchList = ResponseBranchList
+
+// OKResponseElectronicChannelList defines model for OKResponseElectronicChannelList.
+type OKResponseElectronicChannelList = ResponseElectronicChannelList
+
+// OKResponseIntermediaryList defines model for OKResponseIntermediaryList.
+type OKResponseIntermediaryList = ResponseIntermediaryList
+
+// OKResponsePhoneChannelList defines model for OKResponsePhoneChannelList.
+type OKResponsePhoneChannelList = ResponsePhoneChannelList
+
+// OKResponseReferencedNetworkList defines model for OKResponseReferencedNetworkList.
+type OKResponseReferencedNetworkList = ResponseReferencedNetworkList
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ResponseError
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ResponseError
+
+// GetBranchesParams defines parameters for GetBranches.
+type GetBranchesParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetElectronicChannelsParams defines parameters for GetElectronicChannels.
+type GetElectronicChannelsParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetIntermediaryParams defines parameters for GetIntermediary.
+type GetIntermediaryParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetPhoneChannelsParams defines parameters for GetPhoneChannels.
+type GetPhoneChannelsParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetReferencedNetworkParams defines parameters for GetReferencedNetwork.
+type GetReferencedNetworkParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Obtém a lista de dependências próprias da instituição.
+	// (GET /branches)
+	GetBranches(w http.ResponseWriter, r *http.Request, params GetBranchesParams)
+	// Obtém a lista de canais eletrônicos de atendimento da instituição.
+	// (GET /electronic-channels)
+	GetElectronicChannels(w http.ResponseWriter, r *http.Request, params GetElectronicChannelsParams)
+	// Obtém a lista de intermediários da instituição.
+	// (GET /intermediary)
+	GetIntermediary(w http.ResponseWriter, r *http.Request, params GetIntermediaryParams)
+	// Obtém a lista de canais telefônicos de atendimento da instituição.
+	// (GET /phone-channels)
+	GetPhoneChannels(w http.ResponseWriter, r *http.Request, params GetPhoneChannelsParams)
+	// Obtém a lista da rede referenciada da instituição.
+	// (GET /referenced-network)
+	GetReferencedNetwork(w http.ResponseWriter, r *http.Request, params GetReferencedNetworkParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetBranches operation middleware
+func (siw *ServerInterfaceWrapper) GetBranches(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetBranchesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBranches(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetElectronicChannels operation middleware
+func (siw *ServerInterfaceWrapper) GetElectronicChannels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetElectronicChannelsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetElectronicChannels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetIntermediary operation middleware
+func (siw *ServerInterfaceWrapper) GetIntermediary(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetIntermediaryParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIntermediary(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPhoneChannels operation middleware
+func (siw *ServerInterfaceWrapper) GetPhoneChannels(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPhoneChannelsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPhoneChannels(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetReferencedNetwork operation middleware
+func (siw *ServerInterfaceWrapper) GetReferencedNetwork(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetReferencedNetworkParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetReferencedNetwork(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/branches", wrapper.GetBranches)
+	m.HandleFunc("GET "+options.BaseURL+"/electronic-channels", wrapper.GetElectronicChannels)
+	m.HandleFunc("GET "+options.BaseURL+"/intermediary", wrapper.GetIntermediary)
+	m.HandleFunc("GET "+options.BaseURL+"/phone-channels", wrapper.GetPhoneChannels)
+	m.HandleFunc("GET "+options.BaseURL+"/referenced-network", wrapper.GetReferencedNetwork)
+
+	return m
+}
+
+type BadRequestApplicationJSONCharsetUTF8Response ResponseError
+
+type DefaultApplicationJSONCharsetUTF8Response ResponseError
+
+type InternalServerErrorApplicationJSONCharsetUTF8Response ResponseError
+
+type MethodNotAllowedApplicationJSONCharsetUTF8Response ResponseError
+
+type NotAcceptableApplicationJSONCharsetUTF8Response ResponseError
+
+type NotFoundApplicationJSONCharsetUTF8Response ResponseError
+
+type OKResponseBranchListJSONResponse ResponseBranchList
+
+type OKResponseElectronicChannelListJSONResponse ResponseElectronicChannelList
+
+type OKResponseIntermediaryListJSONResponse ResponseIntermediaryList
+
+type OKResponsePhoneChannelListJSONResponse ResponsePhoneChannelList
+
+type OKResponseReferencedNetworkListJSONResponse ResponseReferencedNetworkList
+
+type TooManyRequestsApplicationJSONCharsetUTF8Response ResponseError
+
+type UnprocessableEntityApplicationJSONCharsetUTF8Response ResponseError
+
+type GetBranchesRequestObject struct {
+	Params GetBranchesParams
+}
+
+type GetBranchesResponseObject interface {
+	VisitGetBranchesResponse(w http.ResponseWriter) error
+}
+
+type GetBranches200JSONResponse struct {
+	OKResponseBranchListJSONResponse
+}
+
+func (response GetBranches200JSONResponse) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches400ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches404ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches405ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches406ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches422ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches429ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranches500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetBranches500ApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBranchesdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetBranchesdefaultApplicationJSONCharsetUTF8Response) VisitGetBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetElectronicChannelsRequestObject struct {
+	Params GetElectronicChannelsParams
+}
+
+type GetElectronicChannelsResponseObject interface {
+	VisitGetElectronicChannelsResponse(w http.ResponseWriter) error
+}
+
+type GetElectronicChannels200JSONResponse struct {
+	OKResponseElectronicChannelListJSONResponse
+}
+
+func (response GetElectronicChannels200JSONResponse) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels400ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels404ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels405ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels406ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels422ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels429ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannels500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetElectronicChannels500ApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetElectronicChannelsdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetElectronicChannelsdefaultApplicationJSONCharsetUTF8Response) VisitGetElectronicChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetIntermediaryRequestObject struct {
+	Params GetIntermediaryParams
+}
+
+type GetIntermediaryResponseObject interface {
+	VisitGetIntermediaryResponse(w http.ResponseWriter) error
+}
+
+type GetIntermediary200JSONResponse struct {
+	OKResponseIntermediaryListJSONResponse
+}
+
+func (response GetIntermediary200JSONResponse) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary400ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary404ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary405ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary406ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary422ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary429ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediary500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetIntermediary500ApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetIntermediarydefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetIntermediarydefaultApplicationJSONCharsetUTF8Response) VisitGetIntermediaryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPhoneChannelsRequestObject struct {
+	Params GetPhoneChannelsParams
+}
+
+type GetPhoneChannelsResponseObject interface {
+	VisitGetPhoneChannelsResponse(w http.ResponseWriter) error
+}
+
+type GetPhoneChannels200JSONResponse struct {
+	OKResponsePhoneChannelListJSONResponse
+}
+
+func (response GetPhoneChannels200JSONResponse) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels400ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels404ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels405ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels406ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels422ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels429ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannels500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetPhoneChannels500ApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPhoneChannelsdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetPhoneChannelsdefaultApplicationJSONCharsetUTF8Response) VisitGetPhoneChannelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetReferencedNetworkRequestObject struct {
+	Params GetReferencedNetworkParams
+}
+
+type GetReferencedNetworkResponseObject interface {
+	VisitGetReferencedNetworkResponse(w http.ResponseWriter) error
+}
+
+type GetReferencedNetwork200JSONResponse struct {
+	OKResponseReferencedNetworkListJSONResponse
+}
+
+func (response GetReferencedNetwork200JSONResponse) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork400ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork404ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork405ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork406ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork422ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork429ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetwork500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetReferencedNetwork500ApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReferencedNetworkdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetReferencedNetworkdefaultApplicationJSONCharsetUTF8Response) VisitGetReferencedNetworkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Obtém a lista de dependências próprias da instituição.
+	// (GET /branches)
+	GetBranches(ctx context.Context, request GetBranchesRequestObject) (GetBranchesResponseObject, error)
+	// Obtém a lista de canais eletrônicos de atendimento da instituição.
+	// (GET /electronic-channels)
+	GetElectronicChannels(ctx context.Context, request GetElectronicChannelsRequestObject) (GetElectronicChannelsResponseObject, error)
+	// Obtém a lista de intermediários da instituição.
+	// (GET /intermediary)
+	GetIntermediary(ctx context.Context, request GetIntermediaryRequestObject) (GetIntermediaryResponseObject, error)
+	// Obtém a lista de canais telefônicos de atendimento da instituição.
+	// (GET /phone-channels)
+	GetPhoneChannels(ctx context.Context, request GetPhoneChannelsRequestObject) (GetPhoneChannelsResponseObject, error)
+	// Obtém a lista da rede referenciada da instituição.
+	// (GET /referenced-network)
+	GetReferencedNetwork(ctx context.Context, request GetReferencedNetworkRequestObject) (GetReferencedNetworkResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetBranches operation middleware
+func (sh *strictHandler) GetBranches(w http.ResponseWriter, r *http.Request, params GetBranchesParams) {
+	var request GetBranchesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBranches(ctx, request.(GetBranchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBranches")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBranchesResponseObject); ok {
+		if err := validResponse.VisitGetBranchesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetElectronicChannels operation middleware
+func (sh *strictHandler) GetElectronicChannels(w http.ResponseWriter, r *http.Request, params GetElectronicChannelsParams) {
+	var request GetElectronicChannelsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetElectronicChannels(ctx, request.(GetElectronicChannelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetElectronicChannels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetElectronicChannelsResponseObject); ok {
+		if err := validResponse.VisitGetElectronicChannelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetIntermediary operation middleware
+func (sh *strictHandler) GetIntermediary(w http.ResponseWriter, r *http.Request, params GetIntermediaryParams) {
+	var request GetIntermediaryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetIntermediary(ctx, request.(GetIntermediaryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetIntermediary")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetIntermediaryResponseObject); ok {
+		if err := validResponse.VisitGetIntermediaryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPhoneChannels operation middleware
+func (sh *strictHandler) GetPhoneChannels(w http.ResponseWriter, r *http.Request, params GetPhoneChannelsParams) {
+	var request GetPhoneChannelsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPhoneChannels(ctx, request.(GetPhoneChannelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPhoneChannels")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPhoneChannelsResponseObject); ok {
+		if err := validResponse.VisitGetPhoneChannelsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetReferencedNetwork operation middleware
+func (sh *strictHandler) GetReferencedNetwork(w http.ResponseWriter, r *http.Request, params GetReferencedNetworkParams) {
+	var request GetReferencedNetworkRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReferencedNetwork(ctx, request.(GetReferencedNetworkRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReferencedNetwork")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetReferencedNetworkResponseObject); ok {
+		if err := validResponse.VisitGetReferencedNetworkResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+x8X3PbuHb4V8Hw7sPv16H+WbbjuNNpZUlO1NqSryTnbrtOMxB5LCEhAS4AOvFuPdPv",
+	"0C/Q7X3Y2c7kaXtf9lXfpJ+kA5CU+AeUKMdZ5yZ5SUyRAM7/c3BwcH60HOYHjAKVwjr+0Qowxz5I4PHT",
+	"HNT/LgiHk0ASRq1ja7j8zQfOkItRsPxpTihG34eAQMjlT0gAdRni8H1IBJHYxej/MXSDPcb195z4QPh6",
+	"4PIX1Pr/dcu2iJr5+xD4rWVbFPtgHUfL25ZwFuDjCI5rHHrSOm7Z1jXjPpbWsUWobO9ZtuUTSvzQ1y/l",
+	"bQDRK5gDt+7ubD3ZhPxgwOePIaaSuNgFJJnEHnIBcZgTITkTKGA8gVZsArQmyA8l0O4dmMDF72Jwm83m",
+	"Vujf1XxCazdF4F8AF8s/M+Qv31PiY+QyBNQNGKFSEbxzMUgxg6EAPIYcjwCVUEcj5IIEKhVzmEAuVv9e",
+	"URduFAVEwKgLHDnMRxjdJAthIhD2JEYiDBjXLAYqOaAYRqT+uqmjiSKniwXCak49/C8gkGAecbRkCETV",
+	"hNeMg7+eTNiIIUlk6OE0VAaYrmjoI2f5q0vmTPFMSCxDgfabh2jIJOo4DgQSzzyoX1HLtuAd9gNPEXav",
+	"3qw3E1YuALvA17xMKJ3mZMwQITmh85gfG3ixIw9KkL2iD8GClfKpCZPX14wjMg+xh1ioZmNca3Dq23e1",
+	"G8UHB89g+TP2Fmw1tYZNAEdXVHKsEXKYzxAORYRQmu+7cP2KVuQ7uyfTW/V2OdM3Mlzxe85q8cffvnge",
+	"DVeCEIEmQNvLE+yO4fsQhFRPDqNKudSfOAg84mAlJ43XgtG/Rc4CcwHy70J5XTtSn6wX/4bDtXVs/aGx",
+	"Ns2N6K1ojOPl+pwzBcCdnZPBTiJqy58jMhPkY09bHxfbiPlEEmWhseRkFkomEJtxMsdy+SsnTNhIwGuM",
+	"KEMBvvUYdpWEYMnxzfIXxYzUOIrR5fisrqjTSyzdIyGtXiBCQQTAscs0TAMqgVPsTYDfAI+GPhZ8I4dx",
+	"DiEKfQQKVMrQHEt4i28T+8BC9aNPHM6EAH5Dlj8zhcU5yAVzh0x2PI+9BffxUEAOoyL0ics4UssruXBA",
+	"CMyR8vdOyIW2BApJf/mLZC6LND3RcY2PwmSloo+oJIk9irREAUHoAivYUyYvghS55Bo4UAnaLEkSaLOk",
+	"PK5LMAqAK51Sr5jmsMPo65BKbZ0czLEjgYNIzwLocnpaO4rpccpC+ph8TXi3MtEx3+AdERK0ZCaWhChb",
+	"6gNNuDn6p2T+E46pszgjWy3f7qCnpjbA34u8BFYsCYC6y/+mDsHKqkU8URIpQiWorJ4Fue+BIzmjxOku",
+	"MKXgfRTozauUI6JAxlQ5ePBA8uX/UOKwKuhoe+eDSzC//SiYFBbYiARJvl7+xEklBC4WjMLHZEVhgSpc",
+	"kODBdXUujEFruQPuEORbxt98FEzMq2zQDsRBb2qiYUQFjGW4TBk7x/Q2jmPEI9poprz5OoxRdkroICZg",
+	"RCA/JBKLtCFXceY149hH16DfuUAl13Y49PVOh/uERhE4X75XDoqFiCGP+EQCmntslmz+kghKTekwqt03",
+	"lXp+grAkdE4iC3hJA860G5x50KeSyNtHNOU6clAOWgFLXdA7qSDyRAqi5W+u3rZDst91cQrZPzMbAcJI",
+	"ECrxu8JLBEJipEkhsY38dSRPUMCEWL6/AQ8l5OAq/CdUSB7GdAw4RDsEHTfHWCmklZsV+i/sukQhg70L",
+	"rrgvCQjr+Bp7AvLInqqINopI1WiGMMvZHMVctaZyVnpPk8RVahcfpOb/0QIfE6+4n+urLQcsf9YEhJr6",
+	"qJ7ZUyiiYsn+ISKKEj3mgsfqDvPrMx5t8s+AzuXCOm7vNe3iviJQNkkUl54qs6NeJbzDUsNNJPhim6Ro",
+	"Q2fdrZbDnONb9SyIhI1oMqQ+ySK5kDIQx43G27dv61UQbTX39g2YCompi7lrQPY547GX0BsMoC7xge6A",
+	"8CSeu3ODiYdnxFNqWMB//QObvQZHqi8yI7ZJYFZoiLgIZx5xIvFNxedZ5AbUJQ5GQiljLKrLXxCeAZda",
+	"aIPlb2oWliG65CGsoJ0x5gGmuxDxOqSOwuJhyOgTOojGtww01TaCK9S/S8H30kDsKJLbkcw4x6FNCOQB",
+	"J8oDkOvY/G4bHYE3yI6ppqM4E31+uKIGTEjsdVyXg9g+S+ZjJSXK0Dmwnd/Jd3k25uiWh6ect+pfd0cG",
+	"K8AwjR8qkS1aq6vHbRPQJMVTSGIzX3s4H3NH+bkkj6aTcwHmkjgkwNGmD40CoGhARagWzpnHCcxDrlwM",
+	"RufaKGat4VHR6ueIreGzU2QoJ2+C8m4EnumxJglWYaMS3dzeKeDLXwOu/nIxEswhoIKFylIdq7lBrB0a",
+	"vB6G/gx4EZbu8OIfM+upKE0CdfSeefmfEaeytN8/aB4dtttHzWaz9eQo54b2bSvAUgJXs//r1ZX7Y2v/",
+	"7hvL4Jo2S8guABWEAU3qnfp9JWJNLXvNxHLp6GGJ7yEabjV+RqqdBzWaoBymQcEC72IZFuC86ZE5kUXu",
+	"9Jbv50QylWHW0+sYL5UZLlrkNZee5iTFIBIOcw0i0Y1nX9tHl/HNaynRzC5njAM3i2D59J159CvqApUc",
+	"e/lQzLRW9EPBm+mNgmEtqk6lvrMuRpPp6FWv/6oz7Q97g/P+cDqybOtyOOh11K+988FwMJmOO9PBi86r",
+	"Xn9y3j8/GXd6Hcu2Jv1e37Kt08HZoHNmvUwjEP+2TSX025gtMbVMQlfItnyywUYB0mLc8dBuvBLBfh8P",
+	"Xlj2i3LmpdjvSPZP05tCHrtNgYcp13mvoKOo+Xe7Ss+n4egN5KskQx/L+5dYiOqBwDZLt6ut2cExMy1f",
+	"uaTNYDjtj4f9ae2DHXM8fVp6s0tdKDvkqQ+70XH/h7pnKK5o6woVeAd+4DGUIGej89HJ4Kxvo+7zzlSl",
+	"wv70vDOddC4uzMTIArZvgivkJk2+HJ/lsnArkq/0dntyqHqGIQ4ENDAmaXsGbM5xsCBOlzHuEooliB1l",
+	"zMOqDsEkZ2fxGwQ+mnMcKtQd4mMismSt7bXrB/tPDprNZo7l7Ywdrf29tqT23t3VVT3686nZqnqMzsuA",
+	"Sl5thWr/sH7YPmy1KkLVXkN1ZILKlFBLnxLtGnyt8sAbw67oq11j9EJ2mOa8zJpM3dF43J+OxtUMhMuc",
+	"0AcqSz3xxalSv8gh56HILtzaa+8fHD7RDvhpc7sDbt1982/33diyEHH8gz6fZw7B3jbYuknmN/F3Z9LF",
+	"VczZ753K2mZAs0hmrWfCebXNmfSfXY5HE0Wocf9i3J/0h9POcNpPvTOLTeqDrVbVHA/kRCoe9XKLtv0+",
+	"kXt6xS8qaDch/lnE62SNGNmYJMwf6d8rTs/4h7/SED1HsW3S8rEi86LyVw/Ko5OHHf0zB9zd6HNVJpkI",
+	"B8/BRy7hILHijUuEXP5XMXfV2s+5V2MeLqSS33ax5xE6r746iar/9CFYLv4/OMiZZcOytERNV6XvLCoM",
+	"YTRni9oHT/ZaradPi/HVjnF+Zvo4BXc6+Fal3M5HL/qFLJp+s1mUDbRcYVoqI596Hi0N5OOk0NIQ/D4+",
+	"OL3iF+WDTYh/Fj740b1efMpdPWOXqYu7VySQMS93W7b9JjechXibvHwsL1xU/x298KeRFZt0ug+XEEsJ",
+	"R26PFJ1SKTnqrOt8Km0jtxdhmFJPlaovNhrOKum4FL65DWV/OB13zl5N+2f909Fw0O3YaNLpqk3l6PLF",
+	"oDcaDzoFPuy6a9RvVxQyyll+D76LK199OqDXzCBkLCkKTy4+RcVkWbTO2GuMTnIVcSY+4zWQZVVqjl5R",
+	"5lbo3KAhnoWcoX9+AwK/ITZq7T3JZ1EOmuVBZrlUB3j5PpdLO+FYkErHrUnYtTl2jReJUqmMuyx31Ql7",
+	"wQK31XeDyajWbh0e5uAZd3LULQdlEs565IaI2M5kIZqQuYeVRQ/pqkb0GtykFDcnrRfbQ3gV/nPiyKHR",
+	"cJxgwrnJSuSsgpFx87Jk7ya1N2eIVeA5m8NmLg1OnvUVC/yQEmf5PsgnydoHB812MxcGPClEAU/MQUDA",
+	"hCxZv59L27d0ajlvr48KKx2ZV5LsLTWz47wEs3PMl+89grcyJWebEnXOiUEKBKNUpohhsmeF+vePnWne",
+	"GjWy8lLjVN3J0ZPDg/32nuJcq3nvYHFHZ28EbEP6e3Q66A6GnQ+OBkwZ5gpEGl0Th1DzNuKvI8NsRDIb",
+	"F8Q0ttGzy8Gw+3ykTwjOBipAMDLjnonkTLhcmkQuaNPvs4stLPtFbWVLsf+6n32Y/Sw3+YiSPe1KY0Gk",
+	"ddZ8cepem92ix7rPjreIUyXR+lhb3xLDUX3/a749ugOYbozZ9vJVTQN1jk7oGz1U3+hPOBCQ+pl+Ya9/",
+	"rxFf2RBL90FRYmbNiVyEM3XppeGF5M3tTcNnzpsaUXYEeCPOOXsNHBBNBB8i6PJLnavfH3ClHL01URJM",
+	"Yyg2Ub/0IuwDM8JcsvSVJ0aeJP0KduABqDFZ//tgKSQXos4FIAJwlu+viZNpc5KLFA8O0p7iu6urt1dX",
+	"f7q6Ei//xuQsXJDG63c9/RTdPfRgHt8vZBwtQh9TfdFJyCJc+ah1/2gXYHh077WHJUyJyYkpoUWAFsoj",
+	"uTjqiuBJbKu/VJsNiGDREXgE+/i0W2u3209tdTvVx5Khy2k36+X2mnutWvOgtteaNo+O283jZvNfrFTT",
+	"IBdLqEniw7aQ3M4IthoRSuLVV+g8iIQn02qFkkR6pmB4+V6G3ocwbhchKpx06bOtCLSVgBWZa9JAH79L",
+	"Qs/2lkD0kWxJrOebzIepGcADW/PCCfdXQ27ihKmrwQNzonDK8ZUTJk6UtmZ4YHaYw++vPEnzJE6m7Ej9",
+	"zUdP5kxLdzTtdDsjG3VH6ihGP6hsS+fFYDJ6NYnuLI1yRwmZd9tTUKad2wasDYcbkxh2sdqKiuq3tmNa",
+	"GoqpjBe6dwwLPSYInZtjoeTOub5yDs5ifd88lao+OtblxrnMwd7dcfSfMX/AAqCVVtX36EOev3T39B5r",
+	"vgV442LDKVCPRJt+8DHNXInrjc4Hw2cjfcHt2eWw13l12h/oc5hpf9xdP/3xsjOeph8Hw9TjpP9t+qlz",
+	"0umNstU9+dk3i1+CR5aKdoaTRem805WA0QGf7jfhyLXKWc/YjdJ6uvwZF9NnKDoLQ//77/+B+unY9y8g",
+	"olL9XBeJObupz3hDhAKChlXs/HIx0O0Idb0xxWjdjCfbHkIxhVChKvLjXUKAOUZl4NXQKRaAWvUrekX/",
+	"gEacAF31jYnMIKYSxBWtoVHSflD3W0xaNAgEq9ZYqsgMhxKoTOL8ejwwhlWPxHMeBnoiZZOi1CSs00dR",
+	"v5UAzwmN5kACENb9YlQ6zMvWXCQL5OswAZnSVALmIagTRB+En0AS6afujOgRB6iAFJM7AXYWgPbqTSPX",
+	"sH5dZ3zeiMeKxtmg2x9O+jU1Zr0X0BxMCjNQzcwPy7ZUd8iI5S3dkTNWfOVZjq22/knr8EJbokb6Hvsc",
+	"DHeCRzO5/MVHeE24DffaM5JTj7SF69qLgaskHuRJsp6daYv7ndkcrz9pqG6Sd3aFz6JOoxU+1Z1wK36n",
+	"m9zevcy1ptxrNsscyeq7hrGf251t7VcZnGp+qYfsbx+y6n6nBxxsH1Boh6gHHlZaKdV3UI3a29s+ytTd",
+	"SY99un1svo/WnW0dVCGjqW+lNpGrFpubxye9OJVBF6Hv63s4H6gaEs+V4FvrYis1e2N9VbHmpArHKuqm",
+	"8ernZgNvVNN+8cLkF6iwJX0Gv+ru56i791SdEjUmuSt7FfU3F4bYURvopEMYqLiEQ9z0TQdWto7qHOYD",
+	"V/UA5AfsI73jYa5uKFxJ2zP3R75APS924fyq4p+jihsuW1XVZ10I+gEeOVvafQ+PfJEpzP4ClbTYafar",
+	"kn7GfnhXhSnR23V1R42uS1Yq6u6uJSwZoGLfzaLSO4EK02Eh1CW+VVOogsoX61u+QLUvacv8Vfc/P92v",
+	"olVlqh4XnSZ6YTgJiGtHL1RkrKcqJOTUWY5YlcbFfXgbLABaI0mirZGEAI2bltaD8pWeM595bI5LF1vs",
+	"uNrLFeqr9OKKBHcv7/5vAFYUHYt3agAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -70,7 +70,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /consents/{consentId}", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/consents/v2", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/consents/v2", mux, handler), swaggerVersion
 }
 
 func (s Server) ConsentsPostConsents(ctx context.Context, req ConsentsPostConsentsRequestObject) (ConsentsPostConsentsResponseObject, error) {
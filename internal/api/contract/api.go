@@ -0,0 +1,187 @@
+// Package contract serves the pages where customers confirm the purchase of
+// acknowledged quotes. The quote redirect link points to these pages.
+package contract
+
+import (
+	"errors"
+	"html/template"
+	"log/slog"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/csrf"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	quoteauto "github.com/luikyv/mock-insurer/internal/quote/auto"
+	"github.com/luikyv/mock-insurer/internal/user"
+	"github.com/luikyv/mock-insurer/ui"
+	"github.com/unrolled/secure"
+)
+
+var errNotOwner = errors.New("the user is not the customer of the quote")
+
+type Server struct {
+	host             string
+	quoteAutoService quoteauto.Service
+	userService      user.Service
+	tmpl             *template.Template
+}
+
+func NewServer(host string, quoteAutoService quoteauto.Service, userService user.Service) Server {
+	return Server{
+		host:             host,
+		quoteAutoService: quoteAutoService,
+		userService:      userService,
+		tmpl:             template.Must(template.ParseFS(ui.Templates, "contract.html")),
+	}
+}
+
+func (s Server) RegisterRoutes(mux *http.ServeMux) {
+	secureMiddleware := secure.New(secure.Options{
+		STSSeconds:            31536000,
+		STSIncludeSubdomains:  true,
+		STSPreload:            true,
+		FrameDeny:             true,
+		ContentTypeNosniff:    true,
+		BrowserXssFilter:      true,
+		ContentSecurityPolicy: "default-src 'self'; script-src 'self' $NONCE; style-src 'self' $NONCE",
+	})
+
+	mux.Handle("GET /contract/quote-auto/{orgId}/{quoteId}", secureMiddleware.Handler(http.HandlerFunc(s.quoteAutoHandler)))
+	mux.Handle("POST /contract/quote-auto/{orgId}/{quoteId}", secureMiddleware.Handler(http.HandlerFunc(s.contractQuoteAutoHandler)))
+}
+
+type page struct {
+	ActionURL      string
+	CSRFToken      string
+	ProtocolNumber string
+	Offer          *quoteauto.Offer
+	PolicyID       string
+	Cancelled      bool
+	Error          string
+}
+
+func (s Server) quoteAutoHandler(w http.ResponseWriter, r *http.Request) {
+	orgID, quoteID := r.PathValue("orgId"), r.PathValue("quoteId")
+	p := page{
+		ActionURL: s.host + r.URL.Path,
+	}
+
+	token, err := csrf.Token(w, r)
+	if err != nil {
+		s.renderError(w, p, err)
+		return
+	}
+	p.CSRFToken = token
+
+	q, err := s.quoteAutoService.QuoteByID(r.Context(), quoteID, orgID)
+	if err != nil {
+		s.renderError(w, p, err)
+		return
+	}
+
+	p.fill(q)
+	if q.Status != quote.StatusAcknowledged {
+		p.Error = "This quote was not acknowledged and cannot be contracted."
+	}
+	s.render(w, p)
+}
+
+func (s Server) contractQuoteAutoHandler(w http.ResponseWriter, r *http.Request) {
+	orgID, quoteID := r.PathValue("orgId"), r.PathValue("quoteId")
+	p := page{
+		ActionURL: s.host + r.URL.Path,
+	}
+
+	if !csrf.Valid(r) {
+		slog.InfoContext(r.Context(), "invalid csrf token on the contract page")
+		w.WriteHeader(http.StatusForbidden)
+		p.Error = "Your session expired, please reload the page."
+		s.render(w, p)
+		return
+	}
+	p.CSRFToken = r.PostFormValue(csrf.FormParam)
+
+	q, err := s.quoteAutoService.QuoteByID(r.Context(), quoteID, orgID)
+	if err != nil {
+		s.renderError(w, p, err)
+		return
+	}
+	p.fill(q)
+
+	if r.PostFormValue("confirm") != "true" {
+		p.Cancelled = true
+		s.render(w, p)
+		return
+	}
+
+	owner, err := s.owner(r, q, orgID)
+	if err != nil {
+		s.renderError(w, p, err)
+		return
+	}
+
+	q, err = s.quoteAutoService.Contract(r.Context(), quoteID, orgID, owner.ID)
+	if err != nil {
+		s.renderError(w, p, err)
+		return
+	}
+
+	p.PolicyID = *q.GetIssuedPolicyID()
+	s.render(w, p)
+}
+
+// owner authenticates the user submitting the form and returns the customer of
+// the quote if the user is the customer or, for business quotes, one of its
+// representatives.
+func (s Server) owner(r *http.Request, q *quoteauto.Quote, orgID string) (*user.User, error) {
+	u, err := s.userService.Authenticate(r.Context(), r.PostFormValue("username"), r.PostFormValue("password"), orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cnpj := q.GetBusinessIdentification(); cnpj != nil {
+		return s.userService.Business(r.Context(), u.ID.String(), *cnpj, orgID)
+	}
+
+	if cpf := q.GetPersonalIdentification(); cpf == nil || u.CPF != *cpf {
+		return nil, errNotOwner
+	}
+	return u, nil
+}
+
+func (p *page) fill(q *quoteauto.Quote) {
+	if q.Data.ProtocolNumber != nil {
+		p.ProtocolNumber = *q.Data.ProtocolNumber
+	}
+	p.Offer = q.ChosenOffer()
+	if policyID := q.GetIssuedPolicyID(); policyID != nil {
+		p.PolicyID = *policyID
+	}
+}
+
+func (s Server) renderError(w http.ResponseWriter, p page, err error) {
+	switch {
+	case errors.Is(err, quote.ErrNotFound):
+		p.Error = "Quote not found."
+	case errors.Is(err, quote.ErrAlreadyContracted):
+		p.Error = "This quote was already contracted."
+	case errors.Is(err, user.ErrNotFound), errors.Is(err, user.ErrInvalidCredentials):
+		p.Error = "Invalid credentials."
+	case errors.Is(err, user.ErrLocked):
+		p.Error = "Your account is locked."
+	case errors.Is(err, user.ErrUserDoesNotOwnBusiness), errors.Is(err, errNotOwner):
+		p.Error = "Only the customer of this quote can contract it."
+	case errors.As(err, &errorutil.Error{}):
+		p.Error = err.Error()
+	default:
+		slog.Error("could not contract quote", "error", err)
+		p.Error = "Something went wrong, please try again later."
+	}
+	s.render(w, p)
+}
+
+func (s Server) render(w http.ResponseWriter, p page) {
+	if err := s.tmpl.ExecuteTemplate(w, "contract.html", p); err != nil {
+		slog.Error("could not render contract page", "error", err)
+	}
+}
@@ -87,7 +87,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /business/complimentary-information", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/customers/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/customers/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) CustomersGetPersonalIdentifications(ctx context.Context, req CustomersGetPersonalIdentificationsRequestObject) (CustomersGetPersonalIdentificationsResponseObject, error) {
@@ -0,0 +1,167 @@
+// Package developer serves the endpoints where TPP developers inspect the
+// resource API calls recorded for their client and how their consents evolved.
+// Clients authenticate with a client credentials token bound to their
+// certificate and only see their own calls and consents.
+package developer
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/go-oidc/pkg/provider"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/audit"
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// maxCalls is the number of most recent calls returned.
+const maxCalls = 100
+
+type Server struct {
+	auditService   audit.Service
+	consentService consent.Service
+	op             *provider.Provider
+}
+
+func NewServer(auditService audit.Service, consentService consent.Service, op *provider.Provider) Server {
+	return Server{
+		auditService:   auditService,
+		consentService: consentService,
+		op:             op,
+	}
+}
+
+func (s Server) RegisterRoutes(mux *http.ServeMux) {
+	authMiddleware := middleware.Auth(s.op, goidc.GrantClientCredentials, consent.Scope)
+
+	mux.Handle("GET /developer/api-calls", authMiddleware(http.HandlerFunc(s.apiCallsHandler)))
+	mux.Handle("GET /developer/consents/{consentId}", authMiddleware(http.HandlerFunc(s.consentHandler)))
+}
+
+type APICall struct {
+	ID            string            `json:"id"`
+	ConsentID     string            `json:"consentId,omitempty"`
+	Method        string            `json:"method"`
+	Endpoint      string            `json:"endpoint"`
+	Path          string            `json:"path"`
+	StatusCode    int               `json:"statusCode"`
+	InteractionID string            `json:"interactionId,omitempty"`
+	ErrorCode     string            `json:"errorCode,omitempty"`
+	Latency       int64             `json:"latency"`
+	CreatedAt     timeutil.DateTime `json:"createdAt"`
+}
+
+// apiCallsHandler lists the most recent calls of the client, optionally only
+// the ones made with a consent.
+func (s Server) apiCallsHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	clientID := ctx.Value(api.CtxKeyClientID).(string)
+	orgID := ctx.Value(api.CtxKeyOrgID).(string)
+
+	query := audit.Query{ConsentID: r.URL.Query().Get("consentId"), ClientID: clientID}
+	calls, err := s.auditService.Calls(ctx, query, orgID, page.Pagination{Number: 1, Size: maxCalls})
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	resp := make([]APICall, 0, len(calls.Records))
+	for _, call := range calls.Records {
+		resp = append(resp, APICall{
+			ID:            call.ID.String(),
+			ConsentID:     call.ConsentID,
+			Method:        call.Method,
+			Endpoint:      call.Endpoint,
+			Path:          call.Path,
+			StatusCode:    call.StatusCode,
+			InteractionID: call.InteractionID,
+			ErrorCode:     call.ErrorCode,
+			Latency:       call.Latency,
+			CreatedAt:     call.CreatedAt,
+		})
+	}
+	api.WriteJSON(w, map[string]any{"data": resp, "meta": api.NewPaginatedMeta(calls)}, http.StatusOK)
+}
+
+type Consent struct {
+	ID              string              `json:"id"`
+	Status          consent.Status      `json:"status"`
+	StatusUpdatedAt timeutil.DateTime   `json:"statusUpdatedAt"`
+	Rejection       *consent.Rejection  `json:"rejection,omitempty"`
+	StatusHistory   []StatusChange      `json:"statusHistory"`
+	Permissions     consent.Permissions `json:"permissions"`
+	ExpiresAt       timeutil.DateTime   `json:"expiresAt"`
+	CreatedAt       timeutil.DateTime   `json:"createdAt"`
+}
+
+type StatusChange struct {
+	Status    consent.Status    `json:"status"`
+	Actor     consent.Actor     `json:"actor"`
+	Reason    *string           `json:"reason,omitempty"`
+	CreatedAt timeutil.DateTime `json:"createdAt"`
+}
+
+// consentHandler returns a consent of the client along with its status
+// history.
+func (s Server) consentHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	clientID := ctx.Value(api.CtxKeyClientID).(string)
+	orgID := ctx.Value(api.CtxKeyOrgID).(string)
+	id := r.PathValue("consentId")
+
+	c, err := s.consentService.Consent(ctx, id, orgID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// Consents of other clients are reported as not found so their IDs can't
+	// be probed.
+	if c.ClientID != clientID {
+		writeError(w, r, consent.ErrNotFound)
+		return
+	}
+
+	history, err := s.consentService.StatusHistory(ctx, id, orgID)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+
+	changes := make([]StatusChange, 0, len(history))
+	for _, h := range history {
+		changes = append(changes, StatusChange{
+			Status:    h.Status,
+			Actor:     h.Actor,
+			Reason:    h.Reason,
+			CreatedAt: h.CreatedAt,
+		})
+	}
+	api.WriteJSON(w, map[string]any{"data": Consent{
+		ID:              c.URN(),
+		Status:          c.Status,
+		StatusUpdatedAt: c.StatusUpdatedAt,
+		Rejection:       c.Rejection,
+		StatusHistory:   changes,
+		Permissions:     c.Permissions,
+		ExpiresAt:       c.ExpiresAt,
+		CreatedAt:       c.CreatedAt,
+	}}, http.StatusOK)
+}
+
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if errors.Is(err, consent.ErrNotFound) {
+		api.WriteError(w, r, api.NewError("NOT_FOUND", http.StatusNotFound, err.Error()))
+		return
+	}
+	api.WriteError(w, r, err)
+}
@@ -0,0 +1,31 @@
+package discovery
+
+import (
+	"net/http"
+
+	v1 "github.com/luikyv/mock-insurer/internal/api/discovery/v1"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+)
+
+type Server struct {
+	host     string
+	mtlsHost string
+	service  discovery.Service
+}
+
+func NewServer(host, mtlsHost string, service discovery.Service) Server {
+	return Server{
+		host:     host,
+		mtlsHost: mtlsHost,
+		service:  service,
+	}
+}
+
+func (s Server) RegisterRoutes(mux *http.ServeMux) {
+	muxV1, versionV1 := v1.NewServer(s.host, s.mtlsHost, s.service).Handler()
+
+	mux.Handle("/open-insurance/discovery/v1/", middleware.VersionRouting(map[string]http.Handler{
+		versionV1: muxV1,
+	}))
+}
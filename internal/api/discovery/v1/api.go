@@ -0,0 +1,151 @@
+//go:generate go tool oapi-codegen -config=./config.yml -package=v1 -o=./api_gen.go ./swagger.yml
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+var _ StrictServerInterface = Server{}
+
+type Server struct {
+	host     string
+	mtlsHost string
+	baseURL  string
+	service  discovery.Service
+}
+
+func NewServer(host, mtlsHost string, service discovery.Service) Server {
+	return Server{
+		host:     host,
+		mtlsHost: mtlsHost,
+		baseURL:  host + "/open-insurance/discovery/v1",
+		service:  service,
+	}
+}
+
+func (s Server) Handler() (http.Handler, string) {
+	mux := http.NewServeMux()
+
+	swaggerMiddleware, swaggerVersion := middleware.Swagger(GetSwagger, func(err error) api.Error {
+		return api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error())
+	})
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+			ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				writeResponseError(w, r, err)
+			},
+		}),
+		HandlerMiddlewares: []MiddlewareFunc{swaggerMiddleware},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		},
+	}
+
+	// The discovery API is public, so neither authentication nor the FAPI
+	// interaction ID is required.
+	mux.HandleFunc("GET /status", wrapper.GetStatus)
+	mux.HandleFunc("GET /outages", wrapper.GetOutages)
+
+	return middleware.StripPrefix("/open-insurance/discovery/v1", mux, mux), swaggerVersion
+}
+
+func (s Server) GetStatus(ctx context.Context, req GetStatusRequestObject) (GetStatusResponseObject, error) {
+	outages, err := s.service.ActiveOutages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := timeutil.DateTimeNow()
+	statuses := []Status{}
+	for _, outage := range outages {
+		status := Status{
+			Code:                       SCHEDULEDOUTAGE,
+			Explanation:                outage.Explanation,
+			DetectionDateTime:          &outage.StartsAt,
+			ExpectedResolutionDateTime: &outage.EndsAt,
+			UpdateDateTime:             outage.UpdatedAt,
+		}
+		if outage.IsPartial() {
+			status.UnavailableEndpoints = s.endpoints(outage.Families)
+		}
+		statuses = append(statuses, status)
+	}
+
+	if len(statuses) == 0 {
+		statuses = append(statuses, Status{
+			Code:           OK,
+			Explanation:    "All APIs are available.",
+			UpdateDateTime: now,
+		})
+	}
+
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	statusPage := page.Slice(statuses, pag)
+	resp := ResponseStatusList{
+		Data:  StatusData{Status: statusPage.Records},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/status", statusPage),
+		Meta:  *api.NewPaginatedMeta(statusPage),
+	}
+	return GetStatus200JSONResponse{OKResponseStatusListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetOutages(ctx context.Context, req GetOutagesRequestObject) (GetOutagesResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	outages, err := s.service.Outages(ctx, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ResponseOutageList{
+		Data:  []Outage{},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/outages", outages),
+		Meta:  *api.NewPaginatedMeta(outages),
+	}
+	for _, o := range outages.Records {
+		outage := Outage{
+			OutageDateTime: o.StartsAt,
+			Duration:       o.Duration(),
+			IsPartial:      o.IsPartial(),
+			Explanation:    o.Explanation,
+		}
+		if o.IsPartial() {
+			outage.UnavailableEndpoints = s.endpoints(o.Families)
+		}
+		resp.Data = append(resp.Data, outage)
+	}
+
+	return GetOutages200JSONResponse{OKResponseOutageListJSONResponse(resp)}, nil
+}
+
+// endpoints returns the base URLs of the API families.
+func (s Server) endpoints(families []discovery.Family) *[]string {
+	endpoints := make([]string, 0, len(families))
+	for _, family := range families {
+		host := s.mtlsHost
+		if slices.Contains(discovery.OpenDataFamilies, family) {
+			host = s.host
+		}
+		endpoints = append(endpoints, host+"/open-insurance/"+string(family))
+	}
+	return &endpoints
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
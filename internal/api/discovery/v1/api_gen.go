@@ -0,0 +1,950 @@
+//go:build go1.22
+
+// Package v1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Defines values for StatusCode.
+const (
+	OK              StatusCode = "OK"
+	PARTIALFAILURE  StatusCode = "PARTIAL_FAILURE"
+	SCHEDULEDOUTAGE StatusCode = "SCHEDULED_OUTAGE"
+	UNAVAILABLE     StatusCode = "UNAVAILABLE"
+)
+
+// Outage defines model for Outage.
+type Outage struct {
+	// Duration Duração da indisponibilidade no formato ISO 8601.
+	Duration string `json:"duration"`
+
+	// Explanation Motivo da indisponibilidade.
+	Explanation string `json:"explanation"`
+
+	// IsPartial Indica se apenas parte das APIs ficará indisponível.
+	IsPartial bool `json:"isPartial"`
+
+	// OutageDateTime Data e hora do início da indisponibilidade programada.
+	OutageDateTime timeutil.DateTime `json:"outageDateTime"`
+
+	// UnavailableEndpoints URLs das APIs indisponíveis. Ausente quando todas as APIs ficarão indisponíveis.
+	UnavailableEndpoints *[]string `json:"unavailableEndpoints,omitempty"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Errors []struct {
+		// Code Código de erro específico do endpoint
+		Code string `json:"code"`
+
+		// Detail Descrição legível por humanos deste erro específico
+		Detail string `json:"detail"`
+
+		// RequestDateTime Data e hora da consulta, conforme especificação RFC-3339, formato UTC.
+		RequestDateTime timeutil.DateTime `json:"requestDateTime"`
+
+		// Title Título legível por humanos deste erro específico
+		Title string `json:"title"`
+	} `json:"errors"`
+	Meta *api.Meta `json:"meta,omitempty"`
+}
+
+// ResponseOutageList defines model for ResponseOutageList.
+type ResponseOutageList struct {
+	Data  []Outage  `json:"data"`
+	Links api.Links `json:"links"`
+	Meta  api.Meta  `json:"meta"`
+}
+
+// ResponseStatusList defines model for ResponseStatusList.
+type ResponseStatusList struct {
+	Data  StatusData `json:"data"`
+	Links api.Links  `json:"links"`
+	Meta  api.Meta   `json:"meta"`
+}
+
+// Status defines model for Status.
+type Status struct {
+	// Code Condição atual das APIs (vide Enum):
+	// - OK: as APIs estão funcionando normalmente.
+	// - PARTIAL_FAILURE: parte das APIs está indisponível.
+	// - UNAVAILABLE: as APIs estão indisponíveis.
+	// - SCHEDULED_OUTAGE: as APIs estão em indisponibilidade programada.
+	Code StatusCode `json:"code"`
+
+	// DetectionDateTime Data e hora do início da indisponibilidade.
+	DetectionDateTime *timeutil.DateTime `json:"detectionDateTime,omitempty"`
+
+	// ExpectedResolutionDateTime Data e hora esperadas para o fim da indisponibilidade.
+	ExpectedResolutionDateTime *timeutil.DateTime `json:"expectedResolutionDateTime,omitempty"`
+
+	// Explanation Descrição da condição atual das APIs.
+	Explanation string `json:"explanation"`
+
+	// UnavailableEndpoints URLs das APIs indisponíveis.
+	UnavailableEndpoints *[]string `json:"unavailableEndpoints,omitempty"`
+
+	// UpdateDateTime Data e hora da última atualização do status.
+	UpdateDateTime timeutil.DateTime `json:"updateDateTime"`
+}
+
+// StatusCode Condição atual das APIs (vide Enum):
+// - OK: as APIs estão funcionando normalmente.
+// - PARTIAL_FAILURE: parte das APIs está indisponível.
+// - UNAVAILABLE: as APIs estão indisponíveis.
+// - SCHEDULED_OUTAGE: as APIs estão em indisponibilidade programada.
+type StatusCode string
+
+// StatusData defines model for StatusData.
+type StatusData struct {
+	Status []Status `json:"status"`
+}
+
+// Page defines model for page.
+type Page = int32
+
+// PageSize defines model for pageSize.
+type PageSize = int32
+
+// XMinV defines model for x-min-v.
+type XMinV = string
+
+// XVHeader defines model for x-v.
+type XVHeader = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Default defines model for Default.
+type Default = ResponseError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ResponseError
+
+// MethodNotAllowed defines model for MethodNotAllowed.
+type MethodNotAllowed = ResponseError
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// OKResponseOutageList defines model for OKResponseOutageList.
+type OKResponseOutageList = ResponseOutageList
+
+// OKResponseStatusList defines model for OKResponseStatusList.
+type OKResponseStatusList = ResponseStatusList
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ResponseError
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ResponseError
+
+// GetOutagesParams defines parameters for GetOutages.
+type GetOutagesParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetStatusParams defines parameters for GetStatus.
+type GetStatusParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Obtém a lista de indisponibilidades programadas das APIs da instituição.
+	// (GET /outages)
+	GetOutages(w http.ResponseWriter, r *http.Request, params GetOutagesParams)
+	// Obtém o status atual das APIs da instituição.
+	// (GET /status)
+	GetStatus(w http.ResponseWriter, r *http.Request, params GetStatusParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetOutages operation middleware
+func (siw *ServerInterfaceWrapper) GetOutages(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutagesParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOutages(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatus(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/outages", wrapper.GetOutages)
+	m.HandleFunc("GET "+options.BaseURL+"/status", wrapper.GetStatus)
+
+	return m
+}
+
+type BadRequestApplicationJSONCharsetUTF8Response ResponseError
+
+type DefaultApplicationJSONCharsetUTF8Response ResponseError
+
+type InternalServerErrorApplicationJSONCharsetUTF8Response ResponseError
+
+type MethodNotAllowedApplicationJSONCharsetUTF8Response ResponseError
+
+type NotAcceptableApplicationJSONCharsetUTF8Response ResponseError
+
+type NotFoundApplicationJSONCharsetUTF8Response ResponseError
+
+type OKResponseOutageListJSONResponse ResponseOutageList
+
+type OKResponseStatusListJSONResponse ResponseStatusList
+
+type TooManyRequestsApplicationJSONCharsetUTF8Response ResponseError
+
+type UnprocessableEntityApplicationJSONCharsetUTF8Response ResponseError
+
+type GetOutagesRequestObject struct {
+	Params GetOutagesParams
+}
+
+type GetOutagesResponseObject interface {
+	VisitGetOutagesResponse(w http.ResponseWriter) error
+}
+
+type GetOutages200JSONResponse struct {
+	OKResponseOutageListJSONResponse
+}
+
+func (response GetOutages200JSONResponse) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages400ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages404ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages405ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages406ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages422ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages429ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutages500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetOutages500ApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutagesdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetOutagesdefaultApplicationJSONCharsetUTF8Response) VisitGetOutagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetStatusRequestObject struct {
+	Params GetStatusParams
+}
+
+type GetStatusResponseObject interface {
+	VisitGetStatusResponse(w http.ResponseWriter) error
+}
+
+type GetStatus200JSONResponse struct {
+	OKResponseStatusListJSONResponse
+}
+
+func (response GetStatus200JSONResponse) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus400ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus404ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus405ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus406ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus422ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus429ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatus500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetStatus500ApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetStatusdefaultApplicationJSONCharsetUTF8Response) VisitGetStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Obtém a lista de indisponibilidades programadas das APIs da instituição.
+	// (GET /outages)
+	GetOutages(ctx context.Context, request GetOutagesRequestObject) (GetOutagesResponseObject, error)
+	// Obtém o status atual das APIs da instituição.
+	// (GET /status)
+	GetStatus(ctx context.Context, request GetStatusRequestObject) (GetStatusResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetOutages operation middleware
+func (sh *strictHandler) GetOutages(w http.ResponseWriter, r *http.Request, params GetOutagesParams) {
+	var request GetOutagesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOutages(ctx, request.(GetOutagesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOutages")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetOutagesResponseObject); ok {
+		if err := validResponse.VisitGetOutagesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatus operation middleware
+func (sh *strictHandler) GetStatus(w http.ResponseWriter, r *http.Request, params GetStatusParams) {
+	var request GetStatusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatus(ctx, request.(GetStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatusResponseObject); ok {
+		if err := validResponse.VisitGetStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xaW28bR7L+K4XOeUgOhhdJduDw4DwwlhwTkS0dXXIWawpBcaZItjPTPe4LLSUQsP9h",
+	"f0GQB8MPfgry4lf+k/0li+qZIYfk6JZoIaw3LzbJvn1dl6+quvSTiHWWa0XKWdH7SeRoMCNHpvw2If4/",
+	"IRsbmTupleiJl/OPGRkNCUI+/3kiFcIbT0DWzX8GSyrRYOiNl1Y6TBA+1zDDVJsw38iMpFkunL+HrS/a",
+	"IhKSd37jyVyISCjMSPSK4yNh4yllWOAYo0+d6G1FYqxNhk70hFRuZ1tEIpNKZj4Lg+4ip2KIJmTE5WUU",
+	"NjuWPzbc5/88KicTTAicdphCQmBoIq0z2kKuTYXWXge0ZeWPV6DdftwEF89LuN1u90b0561MqtZsE/x3",
+	"ZOz8Fw3Z/IOSGUKigVSSa6kcC7x/OKgpQ0NOqYY4laQcteEAEnKkHCtHW0iQ/x2qhGYsAZtrlZCBWGeA",
+	"MKsOQmkBU4dgfa5NUDEpZwhKjMCfZm04ZnEmaAF5z7D8N7JgdSrjYBkWFG841oay5WY2Ag1OOp9iHVUD",
+	"pqHyGcTzXxM50awz69B5C4+6X8JL7aAfx5Q7HKXUHioRCTrHLE9ZsNvtbrtbqXJKmJBZ6rKSdF2TpUKs",
+	"M1JNSn1co4s76uCKyw7Vfahg4Xy8YTU81gbkxGMK2vNu2gQPrs09b81YDzGOaP4O06lebB2wWTIwVM5g",
+	"uFCsMw3obXGhut7vovWhuqXe9e9U+lZ752qlX6tw1vdEt8rJf/nuebGcDaGAZinw5deYHNEbT9bxt1gr",
+	"di7+iHmeyhjZTjqvrVb/A/EUjSX3v96NW094yvLw/zI0Fj3xWWdJzZ1i1HaOyuP2jNEM4DJas8F+ZWrz",
+	"d4WYJWSYBvZJMAKdSSeZodEZOfJOW9AjIyfo5r8aqW0Ell4jKA05XqQaE7YQdAZn8/esjNo6hXB6tN9m",
+	"6exWTPdAl+YBkIpsTgYTHTANlCOjMD0mMyNTLH0ofAexNoY8+AyIoSoNE3T0Fi8qftCef8xkbLS1ZGZy",
+	"/k7zLV6Qm+rkpXb9NNVvKXm4K0CslfWZTLQBPp7tIiZr0QDH+9gbG5iAL5nN3zud6MLTKx8P9+GbLFz0",
+	"AZ2k4qPCSxiEVFNk7DXKK5BCIsdkmNsCLTmZB1riiJtIhJwM+xQP6aDhWKvXXrnATjEajB0ZsvVdCE5P",
+	"nrWelPJ4pr16SL1WultQdKk3OpfWUbDMikkkc2lGqtLmwbfV/gfe4YT25Y3Md3fota0b8A9UInmeHMk0",
+	"5HAWcqMnBrMQa/TISf6fDdN6tlfdXkV+HALIvwR5besG5MUoMLr+4aCEqjeQnmj9AtVFGVfsA/qMZnZd",
+	"hhW2GxuCSq6lhcxLh7buWBz3x9pgBmMKYwknKcEvfBYyT5NJVWREZv6BCUN70JDKTDqCSapHVTJeRTTe",
+	"MtYq0KlyYX8J6KSayMIiT1VudKClUUp7ykl38YCuFZicCZPBqoRCZpsXzMCI5h+TUEZRVX8kuBK+IyBA",
+	"sFI5PN8YBLIOIYjCYQTZMrOSkGtr5x9mlEIlDsPpmFTWGV/KMTdUZGwhjylvxZcuHI4/YZJIvgymh4a1",
+	"7yRZ0RtjaikSee2nn0TiDRb3Xs+Kd31lNQmCXHdXDntFbaRhcHwAT77sbrVXErfDk+3nRb20T2ripqK3",
+	"3Y02MzQ6z1NUV2B4oZ2cNQNYPazvPKbyxwqwBiutowzb6wi6TRikPUTjJKabCJinYgRLgDkptJCjcbT0",
+	"/rGM0cx/XuAL2lvBVoq9PHSkdUqo+FQd9LWLjk5k1lDf7qJDIJhqE8pDqeYfYnmFNpbcuSqX7e72Vqv7",
+	"uLW9ddJ90tvp9rrdv4paVZugo5bj42/QVJlKlz/yCu9k2l6gr423ZMaZQ/EQwbuJiXRTP2rHOuukXv5w",
+	"MetkOv6hJZX1hkxHlilfp9o2WLZXOEOZFoRQVGV2U0inR/s1Lq5rQdo29IviBt545OR5Ud/UVPeLXl8l",
+	"IiEdZeGwm22n/AGNwYuysnjjpaFE9F6tqzhaulvd6Fad4Gyxpx69ptjxIaucdTcP58y1eBZa3OoOq2Od",
+	"NJjm02Uhx9sD2Zzi+YexjFeq6DWjevw4YotgZYueeDUcvh0O/384tGf/LRoEm5BD2eCRu+Fb4egpTUq6",
+	"1AamPkMVcjzrNnGtG/ijJ3cBY4owfktnxSLpTpnfY63Y26jAItnoCuxHz562dnZ2vooWPHp68vST8V4n",
+	"XdogppP5B+fTP6K4uxjRmjcGW66gLQxsU7lNHpjh+aBwn62d8OpXfVtngEhk5EISUhc55rL9gn+/F0lj",
+	"LovEpX670s+vo4/VbP8uWQI6XGGQ6xKs4pRNboxEKtUPtkk0+2HgHmXzYFoIkqpuWqK4TiWrZczvUMl1",
+	"mig2Z2r6U/or0i8Ecz+hUKukjEbISegyGfl8JhOCPeWzL3pD1YKDb3uL3IObLpzzexXz4ZycKGb1NAup",
+	"PU8/7B+dDPr73z/rD/ZPj/Z665ln0bdZzTt53enL/nf9wX7/6/29jfPWMh2efvz0+d7u6f7e7vcHpyf9",
+	"bzbXUHZ9vlm81yrugbwSB9+KSKwhF5GoYRKRWD9SnNWDXtiiKRugmEV+L/nyJxNm6Tyn2FFyRFan/vby",
+	"KZ9ci3oGQcNYZneT1Fb3305SV9eZ9ZyyyN6afXpVGicr5UTpLuulxM0VxD0UOn+kZImEz1lvt85t5x9T",
+	"JzME3Ki5A6t+Ir7VnDvWrWhDcFdHmt0yWN8h2thFiLpV0lWcIy6vzU3X7lQesQmbJ0o11tUTHMZBmGUf",
+	"7Rs9Y2mp+bvAswc5KRiwJFHFBF8btDKFf/zt77BXr3h+I8sSM6noialzue11Om/fvm1P9Kw9Mh3rLeUd",
+	"sfl8eTgIPU5DThvFTFWgBgJcusEVr8gLdwnMZp10vnTrkvUasbeHaqg+gwMjSS0eRAuTQuXIhlhe9TlD",
+	"YzeffxylMtaMqnqDn1AG6B0pV1V8Idz2GRDsShuzFC9CGwIVxVQjjhT4mUC5q64YrkahmxxIKUTgVMak",
+	"LNX01M8xnhJst7uNgscw3NZm0inX2s7+4Oney+O9Fq9ZFnFiFXKrWWwiEtw2LtS2FVr1/MiVk8Jcip7Y",
+	"Kbv37JLBnDvF60j4PCG3yTsHIzd/zw30VFqH/Njwe/XNnBSewXnnQcJGTO6gPD5a+euZV81+tpzS4abz",
+	"ZXSLacUfJNxial7US7eaF/4W5vJsrYO93e1exRCLeZ3Gts9lJB7dZnGtRx6WPLp5yaJJFhY8vnnBRtc0",
+	"LPzyVifV2pO8anv75lVNTYew9qub1663dy4j8fg2YmxqbwfSW3Tir19ftezD27/PMjQX9+4pDifsCGLh",
+	"8uKMj+ssQ9J17rpg6LVy6FY+WYax/0CXrDcd/3TJT8Yl7+INjX7HmwZghSOs9aKrRmVCcGh04sNeG+Ge",
+	"X14sTbzBRBvkNJhTLg7NLVlF8U5SndqZbQXLv/qo5zrTqZ7gladN73rc2eL2i/RlKYXLs8t/DgCt/Un7",
+	"8CoAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
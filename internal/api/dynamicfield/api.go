@@ -0,0 +1,32 @@
+package dynamicfield
+
+import (
+	"net/http"
+
+	"github.com/luikyv/go-oidc/pkg/provider"
+	v1 "github.com/luikyv/mock-insurer/internal/api/dynamicfield/v1"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+)
+
+type Server struct {
+	host    string
+	service dynamicfield.Service
+	op      *provider.Provider
+}
+
+func NewServer(host string, service dynamicfield.Service, op *provider.Provider) Server {
+	return Server{
+		host:    host,
+		service: service,
+		op:      op,
+	}
+}
+
+func (s Server) RegisterRoutes(mux *http.ServeMux) {
+	muxV1, versionV1 := v1.NewServer(s.host, s.service, s.op).Handler()
+
+	mux.Handle("/open-insurance/dynamic-fields/v1/", middleware.VersionRouting(map[string]http.Handler{
+		versionV1: muxV1,
+	}))
+}
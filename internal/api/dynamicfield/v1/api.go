@@ -0,0 +1,135 @@
+//go:generate go tool oapi-codegen -config=./config.yml -package=v1 -o=./api_gen.go ./swagger.yml
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/go-oidc/pkg/provider"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+)
+
+var _ StrictServerInterface = Server{}
+
+type Server struct {
+	baseURL string
+	service dynamicfield.Service
+	op      *provider.Provider
+}
+
+func NewServer(host string, service dynamicfield.Service, op *provider.Provider) Server {
+	return Server{
+		baseURL: host + "/open-insurance/dynamic-fields/v1",
+		service: service,
+		op:      op,
+	}
+}
+
+func (s Server) Handler() (http.Handler, string) {
+	mux := http.NewServeMux()
+
+	clientCredentialsMiddleware := middleware.Auth(s.op, goidc.GrantClientCredentials, dynamicfield.Scope)
+	swaggerMiddleware, swaggerVersion := middleware.Swagger(GetSwagger, func(err error) api.Error {
+		return api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error())
+	})
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+			ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				writeResponseError(w, r, err)
+			},
+		}),
+		HandlerMiddlewares: []MiddlewareFunc{swaggerMiddleware},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		},
+	}
+
+	var handler http.Handler
+
+	handler = http.HandlerFunc(wrapper.GetDamageAndPerson)
+	handler = clientCredentialsMiddleware(handler)
+	mux.Handle("GET /damage-and-person", handler)
+
+	handler = http.HandlerFunc(wrapper.GetCapitalizationTitle)
+	handler = clientCredentialsMiddleware(handler)
+	mux.Handle("GET /capitalization-title", handler)
+
+	handler = middleware.FAPIID()(mux)
+	return middleware.StripPrefix("/open-insurance/dynamic-fields/v1", mux, handler), swaggerVersion
+}
+
+func (s Server) GetDamageAndPerson(ctx context.Context, req GetDamageAndPersonRequestObject) (GetDamageAndPersonResponseObject, error) {
+	orgID := ctx.Value(api.CtxKeyOrgID).(string)
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	fields, err := s.service.Fields(ctx, dynamicfield.Query{Catalogue: dynamicfield.CatalogueDamageAndPerson}, orgID, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ResponseDamageAndPersonList{
+		Data:  []DamageAndPersonField{},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/damage-and-person", fields),
+		Meta:  *api.NewPaginatedMeta(fields),
+	}
+	for _, f := range fields.Records {
+		resp.Data = append(resp.Data, DamageAndPersonField{
+			API:           DamageAndPersonFieldAPI(f.API),
+			FieldID:       f.FieldID,
+			Name:          f.Data.Name,
+			Description:   f.Data.Description,
+			Type:          FieldType(f.Data.Type),
+			IsRequired:    f.Data.IsRequired,
+			MaxLength:     f.Data.MaxLength,
+			Pattern:       f.Data.Pattern,
+			AllowedValues: f.Data.AllowedValues,
+		})
+	}
+
+	return GetDamageAndPerson200JSONResponse{OKResponseDamageAndPersonListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetCapitalizationTitle(ctx context.Context, req GetCapitalizationTitleRequestObject) (GetCapitalizationTitleResponseObject, error) {
+	orgID := ctx.Value(api.CtxKeyOrgID).(string)
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	fields, err := s.service.Fields(ctx, dynamicfield.Query{Catalogue: dynamicfield.CatalogueCapitalizationTitle}, orgID, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := ResponseCapitalizationTitleList{
+		Data:  []CapitalizationTitleField{},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/capitalization-title", fields),
+		Meta:  *api.NewPaginatedMeta(fields),
+	}
+	for _, f := range fields.Records {
+		resp.Data = append(resp.Data, CapitalizationTitleField{
+			API:           CapitalizationTitleFieldAPI(f.API),
+			FieldID:       f.FieldID,
+			Name:          f.Data.Name,
+			Description:   f.Data.Description,
+			Type:          FieldType(f.Data.Type),
+			IsRequired:    f.Data.IsRequired,
+			MaxLength:     f.Data.MaxLength,
+			Pattern:       f.Data.Pattern,
+			AllowedValues: f.Data.AllowedValues,
+		})
+	}
+
+	return GetCapitalizationTitle200JSONResponse{OKResponseCapitalizationTitleListJSONResponse(resp)}, nil
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
@@ -0,0 +1,1355 @@
+//go:build go1.22
+
+// Package v1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+const (
+	OAuth2SecurityScopes = "OAuth2Security.Scopes"
+)
+
+// Defines values for CapitalizationTitleFieldAPI.
+const (
+	QuoteCapitalizationTitle CapitalizationTitleFieldAPI = "quote-capitalization-title"
+)
+
+// Defines values for DamageAndPersonFieldAPI.
+const (
+	QuoteAcceptanceAndBranchesAbroad DamageAndPersonFieldAPI = "quote-acceptance-and-branches-abroad"
+	QuoteAuto                        DamageAndPersonFieldAPI = "quote-auto"
+	QuoteFinancialRisk               DamageAndPersonFieldAPI = "quote-financial-risk"
+	QuoteHousing                     DamageAndPersonFieldAPI = "quote-housing"
+	QuotePatrimonialBusiness         DamageAndPersonFieldAPI = "quote-patrimonial-business"
+	QuotePatrimonialCondominium      DamageAndPersonFieldAPI = "quote-patrimonial-condominium"
+	QuotePatrimonialDiverseRisks     DamageAndPersonFieldAPI = "quote-patrimonial-diverse-risks"
+	QuotePatrimonialHome             DamageAndPersonFieldAPI = "quote-patrimonial-home"
+	QuotePersonLife                  DamageAndPersonFieldAPI = "quote-person-life"
+	QuotePersonTravel                DamageAndPersonFieldAPI = "quote-person-travel"
+	QuoteResponsibility              DamageAndPersonFieldAPI = "quote-responsibility"
+	QuoteRural                       DamageAndPersonFieldAPI = "quote-rural"
+	QuoteTransport                   DamageAndPersonFieldAPI = "quote-transport"
+)
+
+// Defines values for FieldType.
+const (
+	BOOLEANO FieldType = "BOOLEANO"
+	DATA     FieldType = "DATA"
+	INTEIRO  FieldType = "INTEIRO"
+	LISTA    FieldType = "LISTA"
+	NUMERO   FieldType = "NUMERO"
+	OBJETO   FieldType = "OBJETO"
+	TEXTO    FieldType = "TEXTO"
+)
+
+// CapitalizationTitleField defines model for CapitalizationTitleField.
+type CapitalizationTitleField struct {
+	// AllowedValues Valores aceitos para o campo.
+	AllowedValues *[]string `json:"allowedValues,omitempty"`
+
+	// API API em que o campo é aceito no objeto `customData`.
+	API CapitalizationTitleFieldAPI `json:"api"`
+
+	// Description Descrição do campo.
+	Description *string `json:"description,omitempty"`
+
+	// FieldID Identificador do campo no objeto `customData`.
+	FieldID FieldID `json:"fieldId"`
+
+	// IsRequired Indica se o campo é obrigatório na requisição.
+	IsRequired bool `json:"isRequired"`
+
+	// MaxLength Tamanho máximo do valor para campos do tipo TEXTO.
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	// Name Nome do campo.
+	Name string `json:"name"`
+
+	// Pattern Expressão regular que o valor de campos do tipo TEXTO deve respeitar.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Type Tipo do valor do campo (vide Enum):
+	// - TEXTO: Texto
+	// - NUMERO: Número decimal
+	// - INTEIRO: Número inteiro
+	// - BOOLEANO: Verdadeiro ou falso
+	// - DATA: Data no formato AAAA-MM-DD
+	// - OBJETO: Objeto JSON
+	// - LISTA: Lista JSON
+	Type FieldType `json:"type"`
+}
+
+// CapitalizationTitleFieldAPI API em que o campo é aceito no objeto `customData`.
+type CapitalizationTitleFieldAPI string
+
+// DamageAndPersonField defines model for DamageAndPersonField.
+type DamageAndPersonField struct {
+	// AllowedValues Valores aceitos para o campo.
+	AllowedValues *[]string `json:"allowedValues,omitempty"`
+
+	// API API em que o campo é aceito no objeto `customData`.
+	API DamageAndPersonFieldAPI `json:"api"`
+
+	// Description Descrição do campo.
+	Description *string `json:"description,omitempty"`
+
+	// FieldID Identificador do campo no objeto `customData`.
+	FieldID FieldID `json:"fieldId"`
+
+	// IsRequired Indica se o campo é obrigatório na requisição.
+	IsRequired bool `json:"isRequired"`
+
+	// MaxLength Tamanho máximo do valor para campos do tipo TEXTO.
+	MaxLength *int `json:"maxLength,omitempty"`
+
+	// Name Nome do campo.
+	Name string `json:"name"`
+
+	// Pattern Expressão regular que o valor de campos do tipo TEXTO deve respeitar.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Type Tipo do valor do campo (vide Enum):
+	// - TEXTO: Texto
+	// - NUMERO: Número decimal
+	// - INTEIRO: Número inteiro
+	// - BOOLEANO: Verdadeiro ou falso
+	// - DATA: Data no formato AAAA-MM-DD
+	// - OBJETO: Objeto JSON
+	// - LISTA: Lista JSON
+	Type FieldType `json:"type"`
+}
+
+// DamageAndPersonFieldAPI API em que o campo é aceito no objeto `customData`.
+type DamageAndPersonFieldAPI string
+
+// FieldID Identificador do campo no objeto `customData`.
+type FieldID = string
+
+// FieldType Tipo do valor do campo (vide Enum):
+// - TEXTO: Texto
+// - NUMERO: Número decimal
+// - INTEIRO: Número inteiro
+// - BOOLEANO: Verdadeiro ou falso
+// - DATA: Data no formato AAAA-MM-DD
+// - OBJETO: Objeto JSON
+// - LISTA: Lista JSON
+type FieldType string
+
+// ResponseCapitalizationTitleList defines model for ResponseCapitalizationTitleList.
+type ResponseCapitalizationTitleList struct {
+	// Data Lista de campos dinâmicos.
+	Data  []CapitalizationTitleField `json:"data"`
+	Links api.Links                  `json:"links"`
+	Meta  api.Meta                   `json:"meta"`
+}
+
+// ResponseDamageAndPersonList defines model for ResponseDamageAndPersonList.
+type ResponseDamageAndPersonList struct {
+	// Data Lista de campos dinâmicos.
+	Data  []DamageAndPersonField `json:"data"`
+	Links api.Links              `json:"links"`
+	Meta  api.Meta               `json:"meta"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Errors []struct {
+		// Code Código de erro específico do endpoint
+		Code string `json:"code"`
+
+		// Detail Descrição legível por humanos deste erro específico
+		Detail string `json:"detail"`
+
+		// RequestDateTime Data e hora da consulta, conforme especificação RFC-3339, formato UTC.
+		RequestDateTime timeutil.DateTime `json:"requestDateTime"`
+
+		// Title Título legível por humanos deste erro específico
+		Title string `json:"title"`
+	} `json:"errors"`
+	Meta *api.Meta `json:"meta,omitempty"`
+}
+
+// Authorization defines model for Authorization.
+type Authorization = string
+
+// Page defines model for page.
+type Page = int32
+
+// PageSize defines model for pageSize.
+type PageSize = int32
+
+// XMinV defines model for x-min-v.
+type XMinV = string
+
+// XVHeader defines model for x-v.
+type XVHeader = string
+
+// XCustomerUserAgent defines model for xCustomerUserAgent.
+type XCustomerUserAgent = string
+
+// XFapiAuthDate defines model for xFapiAuthDate.
+type XFapiAuthDate = string
+
+// XFapiCustomerIPAddress defines model for xFapiCustomerIpAddress.
+type XFapiCustomerIPAddress = string
+
+// XFapiInteractionID defines model for xFapiInteractionId.
+type XFapiInteractionID = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Default defines model for Default.
+type Default = ResponseError
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ResponseError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ResponseError
+
+// MethodNotAllowed defines model for MethodNotAllowed.
+type MethodNotAllowed = ResponseError
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// OKResponseCapitalizationTitleList defines model for OKResponseCapitalizationTitleList.
+type OKResponseCapitalizationTitleList = ResponseCapitalizationTitleList
+
+// OKResponseDamageAndPersonList defines model for OKResponseDamageAndPersonList.
+type OKResponseDamageAndPersonList = ResponseDamageAndPersonList
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ResponseError
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ResponseError
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ResponseError
+
+// GetCapitalizationTitleParams defines parameters for GetCapitalizationTitle.
+type GetCapitalizationTitleParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// Authorization Cabeçalho HTTP padrão. Permite que as credenciais sejam fornecidas dependendo do tipo de recurso solicitado
+	Authorization Authorization `json:"Authorization"`
+
+	// XFapiAuthDate Data em que o usuário logou pela última vez com o receptor. Representada de acordo com a [RFC7231](https://tools.ietf.org/html/rfc7231).Exemplo: Sun, 10 Sep 2017 19:43:31 UTC
+	XFapiAuthDate *XFapiAuthDate `json:"x-fapi-auth-date,omitempty"`
+
+	// XFapiCustomerIPAddress O endereço IP do usuário se estiver atualmente logado com o receptor.
+	XFapiCustomerIPAddress *XFapiCustomerIPAddress `json:"x-fapi-customer-ip-address,omitempty"`
+
+	// XFapiInteractionID Um UID [RFC4122](https://tools.ietf.org/html/rfc4122) usado como um ID de correlação. Se fornecido, o transmissor deve "reproduzir" esse valor no cabeçalho de resposta.
+	XFapiInteractionID XFapiInteractionID `json:"x-fapi-interaction-id"`
+
+	// XCustomerUserAgent Indica o user-agent que o usuário utiliza.
+	XCustomerUserAgent *XCustomerUserAgent `json:"x-customer-user-agent,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetDamageAndPersonParams defines parameters for GetDamageAndPerson.
+type GetDamageAndPersonParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// Authorization Cabeçalho HTTP padrão. Permite que as credenciais sejam fornecidas dependendo do tipo de recurso solicitado
+	Authorization Authorization `json:"Authorization"`
+
+	// XFapiAuthDate Data em que o usuário logou pela última vez com o receptor. Representada de acordo com a [RFC7231](https://tools.ietf.org/html/rfc7231).Exemplo: Sun, 10 Sep 2017 19:43:31 UTC
+	XFapiAuthDate *XFapiAuthDate `json:"x-fapi-auth-date,omitempty"`
+
+	// XFapiCustomerIPAddress O endereço IP do usuário se estiver atualmente logado com o receptor.
+	XFapiCustomerIPAddress *XFapiCustomerIPAddress `json:"x-fapi-customer-ip-address,omitempty"`
+
+	// XFapiInteractionID Um UID [RFC4122](https://tools.ietf.org/html/rfc4122) usado como um ID de correlação. Se fornecido, o transmissor deve "reproduzir" esse valor no cabeçalho de resposta.
+	XFapiInteractionID XFapiInteractionID `json:"x-fapi-interaction-id"`
+
+	// XCustomerUserAgent Indica o user-agent que o usuário utiliza.
+	XCustomerUserAgent *XCustomerUserAgent `json:"x-customer-user-agent,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Obtém a lista de campos dinâmicos de Títulos de Capitalização.
+	// (GET /capitalization-title)
+	GetCapitalizationTitle(w http.ResponseWriter, r *http.Request, params GetCapitalizationTitleParams)
+	// Obtém a lista de campos dinâmicos de Danos e Pessoas.
+	// (GET /damage-and-person)
+	GetDamageAndPerson(w http.ResponseWriter, r *http.Request, params GetDamageAndPersonParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetCapitalizationTitle operation middleware
+func (siw *ServerInterfaceWrapper) GetCapitalizationTitle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2SecurityScopes, []string{"dynamic-fields"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCapitalizationTitleParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization Authorization
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "x-fapi-auth-date" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-auth-date")]; found {
+		var XFapiAuthDate XFapiAuthDate
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-auth-date", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-auth-date", valueList[0], &XFapiAuthDate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-auth-date", Err: err})
+			return
+		}
+
+		params.XFapiAuthDate = &XFapiAuthDate
+
+	}
+
+	// ------------- Optional header parameter "x-fapi-customer-ip-address" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-customer-ip-address")]; found {
+		var XFapiCustomerIPAddress XFapiCustomerIPAddress
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-customer-ip-address", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-customer-ip-address", valueList[0], &XFapiCustomerIPAddress, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-customer-ip-address", Err: err})
+			return
+		}
+
+		params.XFapiCustomerIPAddress = &XFapiCustomerIPAddress
+
+	}
+
+	// ------------- Required header parameter "x-fapi-interaction-id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-interaction-id")]; found {
+		var XFapiInteractionID XFapiInteractionID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-interaction-id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-interaction-id", valueList[0], &XFapiInteractionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-interaction-id", Err: err})
+			return
+		}
+
+		params.XFapiInteractionID = XFapiInteractionID
+
+	} else {
+		err := fmt.Errorf("Header parameter x-fapi-interaction-id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "x-fapi-interaction-id", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "x-customer-user-agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-customer-user-agent")]; found {
+		var XCustomerUserAgent XCustomerUserAgent
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-customer-user-agent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-customer-user-agent", valueList[0], &XCustomerUserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-customer-user-agent", Err: err})
+			return
+		}
+
+		params.XCustomerUserAgent = &XCustomerUserAgent
+
+	}
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapitalizationTitle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDamageAndPerson operation middleware
+func (siw *ServerInterfaceWrapper) GetDamageAndPerson(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, OAuth2SecurityScopes, []string{"dynamic-fields"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDamageAndPersonParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization Authorization
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "x-fapi-auth-date" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-auth-date")]; found {
+		var XFapiAuthDate XFapiAuthDate
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-auth-date", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-auth-date", valueList[0], &XFapiAuthDate, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-auth-date", Err: err})
+			return
+		}
+
+		params.XFapiAuthDate = &XFapiAuthDate
+
+	}
+
+	// ------------- Optional header parameter "x-fapi-customer-ip-address" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-customer-ip-address")]; found {
+		var XFapiCustomerIPAddress XFapiCustomerIPAddress
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-customer-ip-address", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-customer-ip-address", valueList[0], &XFapiCustomerIPAddress, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-customer-ip-address", Err: err})
+			return
+		}
+
+		params.XFapiCustomerIPAddress = &XFapiCustomerIPAddress
+
+	}
+
+	// ------------- Required header parameter "x-fapi-interaction-id" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-fapi-interaction-id")]; found {
+		var XFapiInteractionID XFapiInteractionID
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-fapi-interaction-id", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-fapi-interaction-id", valueList[0], &XFapiInteractionID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-fapi-interaction-id", Err: err})
+			return
+		}
+
+		params.XFapiInteractionID = XFapiInteractionID
+
+	} else {
+		err := fmt.Errorf("Header parameter x-fapi-interaction-id is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "x-fapi-interaction-id", Err: err})
+		return
+	}
+
+	// ------------- Optional header parameter "x-customer-user-agent" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-customer-user-agent")]; found {
+		var XCustomerUserAgent XCustomerUserAgent
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-customer-user-agent", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-customer-user-agent", valueList[0], &XCustomerUserAgent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-customer-user-agent", Err: err})
+			return
+		}
+
+		params.XCustomerUserAgent = &XCustomerUserAgent
+
+	}
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDamageAndPerson(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/capitalization-title", wrapper.GetCapitalizationTitle)
+	m.HandleFunc("GET "+options.BaseURL+"/damage-and-person", wrapper.GetDamageAndPerson)
+
+	return m
+}
+
+type BadRequestApplicationJSONCharsetUTF8Response ResponseError
+
+type DefaultApplicationJSONCharsetUTF8Response ResponseError
+
+type ForbiddenApplicationJSONCharsetUTF8Response ResponseError
+
+type InternalServerErrorApplicationJSONCharsetUTF8Response ResponseError
+
+type MethodNotAllowedApplicationJSONCharsetUTF8Response ResponseError
+
+type NotAcceptableApplicationJSONCharsetUTF8Response ResponseError
+
+type NotFoundApplicationJSONCharsetUTF8Response ResponseError
+
+type OKResponseCapitalizationTitleListJSONResponse ResponseCapitalizationTitleList
+
+type OKResponseDamageAndPersonListJSONResponse ResponseDamageAndPersonList
+
+type TooManyRequestsApplicationJSONCharsetUTF8Response ResponseError
+
+type UnauthorizedApplicationJSONCharsetUTF8Response ResponseError
+
+type UnprocessableEntityApplicationJSONCharsetUTF8Response ResponseError
+
+type GetCapitalizationTitleRequestObject struct {
+	Params GetCapitalizationTitleParams
+}
+
+type GetCapitalizationTitleResponseObject interface {
+	VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error
+}
+
+type GetCapitalizationTitle200JSONResponse struct {
+	OKResponseCapitalizationTitleListJSONResponse
+}
+
+func (response GetCapitalizationTitle200JSONResponse) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle400ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle401ApplicationJSONCharsetUTF8Response struct {
+	UnauthorizedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle401ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle403ApplicationJSONCharsetUTF8Response struct {
+	ForbiddenApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle403ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle404ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle405ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle406ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle422ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle429ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle500ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitledefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetCapitalizationTitledefaultApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetDamageAndPersonRequestObject struct {
+	Params GetDamageAndPersonParams
+}
+
+type GetDamageAndPersonResponseObject interface {
+	VisitGetDamageAndPersonResponse(w http.ResponseWriter) error
+}
+
+type GetDamageAndPerson200JSONResponse struct {
+	OKResponseDamageAndPersonListJSONResponse
+}
+
+func (response GetDamageAndPerson200JSONResponse) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson400ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson401ApplicationJSONCharsetUTF8Response struct {
+	UnauthorizedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson401ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson403ApplicationJSONCharsetUTF8Response struct {
+	ForbiddenApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson403ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson404ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson405ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson406ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson422ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson429ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPerson500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetDamageAndPerson500ApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetDamageAndPersondefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetDamageAndPersondefaultApplicationJSONCharsetUTF8Response) VisitGetDamageAndPersonResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Obtém a lista de campos dinâmicos de Títulos de Capitalização.
+	// (GET /capitalization-title)
+	GetCapitalizationTitle(ctx context.Context, request GetCapitalizationTitleRequestObject) (GetCapitalizationTitleResponseObject, error)
+	// Obtém a lista de campos dinâmicos de Danos e Pessoas.
+	// (GET /damage-and-person)
+	GetDamageAndPerson(ctx context.Context, request GetDamageAndPersonRequestObject) (GetDamageAndPersonResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetCapitalizationTitle operation middleware
+func (sh *strictHandler) GetCapitalizationTitle(w http.ResponseWriter, r *http.Request, params GetCapitalizationTitleParams) {
+	var request GetCapitalizationTitleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapitalizationTitle(ctx, request.(GetCapitalizationTitleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapitalizationTitle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapitalizationTitleResponseObject); ok {
+		if err := validResponse.VisitGetCapitalizationTitleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetDamageAndPerson operation middleware
+func (sh *strictHandler) GetDamageAndPerson(w http.ResponseWriter, r *http.Request, params GetDamageAndPersonParams) {
+	var request GetDamageAndPersonRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetDamageAndPerson(ctx, request.(GetDamageAndPersonRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetDamageAndPerson")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetDamageAndPersonResponseObject); ok {
+		if err := validResponse.VisitGetDamageAndPersonResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+w7y3Lbxpa/0oWbhT0F8CUpsTg1C0aPRB7rMRLleyumUjpEH5JtA91wd4OWbKpq/mF+",
+	"YDx34crCq1t3ky3/ZL5k6jQAkiBBiU5yS1N1vUlEdJ/u83718QcvVHGiJEprvPYHLwENMVrU7lcntSOl",
+	"xXuwQkn6wNGEWiTZT28P+jj9BNFIsR+73TOWANfTv6oaO0MdC4vsbYoMDAs1cpShAGGYwdcQs4HSEkPB",
+	"wTCOCUqOkivGFbMiUYwj0xim2ihmVCRCYYErz/cEXTpC4Kg935MQo9dewtH3NL5NhUbuta1O0fdMOMIY",
+	"CPkYbl6gHNqR1241tp/5XgLWoqZDX/V673q9P/d65upfPN+ztwkdbawWcujd3dHWIa4y4GT6a4xaMQ4s",
+	"mX4cCgmOZDR2+pEZR5NDxxAFwJ4oNoZIabdfixiFngNOf2HNp7WCyrcp6ts5ke76RVo4DiCNrNdu+t5A",
+	"6Ris1/aEtFstz/diIUWcxm4xJ0VIi0PUM1ouxPsKev4jBWkFB47MKgtRJomhMFYrwxKlC2zNfYgGRrxf",
+	"g21rpwpduMnRbTQaD2J/E8RCBuNV5F+iNtO/KhZPP0sRA2kTSp4oIS0xvHN2tCAMxRKMFAsjgdJijZ0y",
+	"jhalJeEowzjQf3uS45g4YBIlOWoWqpgBGxcXkT5DZIGZNFHaiRil1chyHBn9Na6xC2In6TrQmQ7872jm",
+	"ug2GSTpwoDTG88OMz8ggbBrBIlYVOPVkGrNw+jcuhs56jAWbGrbd+JadKMs6YYiJhX6EtR6ZCN5AnETE",
+	"2FatUWuss6yC04uSXLWMm/tk8YUyWENsT/4RIpgZHx1YLA+UZmKYQsRUSqcp7Sx4Ye9NMCY5hHNfV8A6",
+	"3Axq1pNWgyMoVLFikJqMoEW5f4nUe3JDuavfKPRmbWu90O8VOMl7qIJ8819e/piB0/e91FgVo740qDtD",
+	"lHZVLY4kFyEwxVKDOgDalLM7Nen0oxaKpVZE4j3U1uMX5vcE80O8NW6+mfuT2e8v8vk3h5AICi/7YCuc",
+	"5T5YYBgvExCpoUpJs4FNf40seaIxvs/FpZGEonSNnWOikfSEVJYjg1DpTIEYsFfnh3vftbaaV09G1iam",
+	"Xa9bpSJTE2gHNaWH9ZGNo7oehLTpae3gBuMkUm12kUqfNRvsAhPWajS/Y83d9vZWe6vJLrt76zk6gEQE",
+	"kNpRwInSdTFzt8TL1u4iM39+cqzkpJvi5M/IJ91ROjnUYnIBdnKRyqc+6/X4h9Yde/Ic5OQQ+5Nj0JNO",
+	"oifHcDt5nsrJ8zSadNLh5AKTyWloJydqPNnH8KkD3L7L4dul/7EnPxx3J5fdvaffrJdfoZVHSYdzjcas",
+	"CvKUfBRqnH5S7OiM8QVhGhfMxRg1A5tCFJNhk4hzY18U6QP8namtSALIUfnHqe2RtKghJAqP+CrJlzG7",
+	"PNp3erbdbLUe1DPa9JSlZubj0pgd7ZPehkprjGD6ySV+FzhL7ZSLXhqkiYUxSueu3NOYaMXT90L3PIbG",
+	"YO5qZcnH8tzVGQsPMVbMSQ0E3zQDvJfHP7+C4H0n+KkR7F7N/+z1gqsPDX93965K3+58L3POBp2OfQ/8",
+	"HN+maJwfDJW0uUuEJIlE6NLV+muj5L+ycATaoP231A6CZ7RljvE3Ggde2/tTfZ6m17NVUz/PrzvQWukM",
+	"g7KUO0WwddJhAyVYDJHLvzj4TMXCCspRwWrRT60yTPW1GIKd/k0LZXyXrJNgEriNFHCKkWA1jKe/GOez",
+	"ZnAS2OX5ixrFgf0i13skommBCYkmQQ1cOZwOle4LzlE+GlanzKo3KJnFmKEJVUI4OtuxitiaxsASFU0/",
+	"WwqQFM1xmGqQ00/g5DYWKgIORIwzbQnRBeox6uzGRyPLkUDoMyS+S8WGYPEd3BbpnkrpYyxCrYxBPRbT",
+	"T4qoOEY7UvxE2U4UqXfIH1EyoZImjQVXmtH1pOQhGgM68+6uDiVfn8Ysnv5iFVdZ4lakbI4eomSWcT2i",
+	"xRfpZWbyhISQIyDcF7xrhinjYoDaRTTKL61IXJZJBRQXwBJXxAtacgpKh71OpXXOOQRyuajRLJ6C7LJ7",
+	"GDzL+XGoUvmYcl3tIWRywxthLDrNLNyioNQ4RllI8/Tfi/P3IBEWory/0BU2whfiQZ/+5XSsu6eCsj2I",
+	"naSEnP5PLMJMaN3pZ5tG2d/zszItUP1MjKTEJiXdVrUylfsQwxA7kp+hNkr+QyisumNT6vZBKsOQnRHu",
+	"YNZS1FXqGORtHnjNI9qhovAzj7uki8ZF3UQJw+JUWDCLxkql4UBp6o2hW+NUxzpbS2PXnNCxkFnRrKef",
+	"yQmplCkWCddpG0aqX/RripBPR4YqizLSuvMFAyvkUGRafikhb549ov/dKyV9kBIGIsxZl5fSdSHH04+R",
+	"yIjOIunsU0ZJopVz2v0ID6QV9vYRHY+LcxROCHfJkc37mg6j6a/c9QyxaLZxKGVqPkMGzAhp4WZlkaGx",
+	"kKXdFnwWz9sIgiXKmOnnMUasYIdmYJiQxuo014i87MSaKxZyqojoCg90KDBymgGcC/oK0ZkmzbYCjdce",
+	"QGTQ95KFTx88yOL5S4hSrCi2XlKyj4ZCrLDKsAQ0uPZKnCiX6VuMTVWuvtKIyD+A1nBLvyERq9dRCjKr",
+	"0d0l1GjNLqfERPVfo1XsOqvOqKS/JixQUgPylfc2VRaDsMSZwBJrvKvFlso9+yowL6G40lZwvzJR8wXG",
+	"LPBjp5IfAxLWEX9If51Mj/YJQpjzWam0plVjSnxbLA+YLOtlbbHHlGtGjmNfqQhBenclKpZv7EIMckT9",
+	"2483InbEZ5WhU5EwDwu5KXUP/tI9LV3ZfKh3XNSNK018FWOJ03Oxnmk1EKboZIZK8tQqXRZGtXLOasnl",
+	"2w5uyADdkRqHrsW31HCsonTe/ENhQZex/PlVJ/jp6sPW3TdlzHbuMZsNdKRLG7OytlCSV87I5qqWszQ/",
+	"tKRQV7OrycRC60rDcgbw1bs4rwGpVZ5f/MhqCBliAJIHfQ0yHKEJoK8V8Nm2gZAgQwFRoIV5M/s8Uqkh",
+	"/IvfCVgtYiVp40jFWLlAaq3IcNK4cr1PZ2bdqtVFTr0xgw6NhR1OvkEkBrj8jdoHGM2+5i0T0RcRxezZ",
+	"51TDfJPrIlGtVeV0c/Z9dbJfnexXJ0u9rlz1VpWNKgoxECFQalrI4j43NSN+57tnQWJ48F0z5t/uftd8",
+	"83oUtPh2c7tCSr+ri+p7c66s6q7LoWdCLAh4MhYc2YFM46ftngwyWbZZF2+sot8nl8cH56dtNnusx1DE",
+	"ENHS0Un34GhxjfRYaAf2/enpi4POyWmbvURNWbrQrvogq3Mb9jvdTpu5VyDpXvFisIp1Op1OcHwc7O/T",
+	"ntPvnx8QMqcZh59fnJ7Q5xdHFwRLJTDkHxfCgiPA870Mcc/3cjQ93yuQ8nyPrvd8L7vB8z13ZtlBFget",
+	"MHmD5sYXxGQOFlallRG3YGqzgr4UiO+zkLUVSUV8joR84w5075PFaiJqL9yCP/8eiNjFEjftQmrrDYUd",
+	"pf1aqOJ6lIo3t+N6rMI3gZAm1ajrIm+41skuyVZjzAhevuqYvv+BNy25BcfngtIciyoP8EBT5/+DZCsz",
+	"wa9S3USqs4b/F8gRCcb9NZPOF0CHild447355AEdzyhshtPPAxGWxj7KEaK1s7P5WyblcBZEdH/6FuEw",
+	"b3kozUZp7DqFHI1dxcvzf/sMWCY0NJaGAboiXjsQwEZKAzVt3LNCRD2aUEkKD5jh4kJwhvv54V6wtbW1",
+	"68/Cx2V3rxx6W41WM2jsBK1mt/GsvdVoNxo/eQtDVBwoQRYxLhO3TIFfUmyCoFGL2oycP0TDi2OdQWX9",
+	"j9UwnnWqf4fgvkSJlqzN6XKB2kzBVoVbZYEx3Bxl5tPccllw8WvVbz2SL8ntfBV56vTRa4iwtxfkgjPD",
+	"PqXhltZFvrAqqcMovVHM7WISQ8qo3VSEqxXcbOds+gGYRXpnci9nioEyq9GBjEJIY4VN59XMIFLvMi/j",
+	"xsD23KioFRCZrCWrkjwU3UqIRRi4rNhQjp+9oq7glWMw/W/3/LifgTEXYYx7I3Dt40sdeW2vmHqgNrhx",
+	"z6m13PLqblemxjkraVMr47mQA1X0lyF04stnEn5QY5KPe7flip0mKNkRyY5Keva9BiMi9r//+V/sYNEV",
+	"/B2N53tpCad3797Vhmpc6+u6SQ0mdW/1leHsyIlBo1VaAqtk+qwVgtES+9ek/oyDId6ZrF+dPyXWerIn",
+	"/8RONUkpx5ll2gvSonHZ7ux+V3rBUKeJG11LMHK42elHGo+qfNJ5cl3nLitwjY+sW3D9lOFDj1tPrutV",
+	"fdfrpzVCaQ94XtYykRXbwBa6NrNujc/EvDwC55KuIRHX1IpXi2u0kFdm1+6I/YOXB24KMJ9c42tLKsLn",
+	"vPQ6s8hg95aVs8819DkOhBRcGb/4XJrOKN5G3OvsOO97CUnJFlhyrMLkE0o2r5syNuRV8WvMZxCzPcXw",
+	"YKvl0Ow4JmXcMcbxMHsAz95esuc3NhaQT2+ycG63+Yn5lMN12XCvs2HESIQoDS4YTieBcISsVWtUWgK4",
+	"ZTeTlMOa+oujvYOTi4OAYObhxls1exZUG6Lne9S9yoyp6UZh73xPJShdt8/bcp9crBk5J1SpabQwxIpp",
+	"x9O+nf4SM2DR2oT5AeUmB+keEos5Lu8HtBVVkeeXBvdfVSff8y318tD8nf8gQHkWclOA1eG7TSHL82ub",
+	"QK2On24CFYw325ZNQm+w1U3qb7jPDeHfXS0NjrUajXXl02xf/eEBhTvf297kpIU5NQfSfBik9G7sgLYe",
+	"BprPYDmI7YchZiMkDmDnYYCVmSIH+O1GNy0M7xBUq7UJG1YfnR3s7sOwy4MKd763s4m0qoa/XF4wG7q7",
+	"H76YzlvMCJ3DWM4FXy1nXFekqCaNY9C3f5hzszAkf+WV/bV3ReitpgO/19MupRyV3nWpM/HVs/7zetbK",
+	"gaWvXvWrV310r1rhyNZ7UocT0ZX5r7LnvChGlTiyM5rLd655JQWn3kU2kcyVBupOUF1KmXIgiqS6Xiat",
+	"Pm46a11/348qVvRPKdZeOfpNd17NmDErLpaYcnd1938DABtkquX4OgAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
@@ -78,7 +78,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-financial-assistance/{contractId}/movements", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-financial-assistance/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-financial-assistance/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceFinancialAssistance(ctx context.Context, req GetInsuranceFinancialAssistanceRequestObject) (GetInsuranceFinancialAssistanceResponseObject, error) {
@@ -84,7 +84,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-financial-risk/{policyId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-financial-risk/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-financial-risk/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceFinancialRisk(ctx context.Context, req GetInsuranceFinancialRiskRequestObject) (GetInsuranceFinancialRiskResponseObject, error) {
@@ -85,7 +85,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-housing/{policyId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-housing/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-housing/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceHousing(ctx context.Context, req GetInsuranceHousingRequestObject) (GetInsuranceHousingResponseObject, error) {
@@ -94,7 +94,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-life-pension/{certificateId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-life-pension/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-life-pension/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsuranceLifePension(ctx context.Context, req GetInsuranceLifePensionRequestObject) (GetInsuranceLifePensionResponseObject, error) {
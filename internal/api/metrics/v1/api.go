@@ -0,0 +1,134 @@
+//go:generate go tool oapi-codegen -config=./config.yml -package=v1 -o=./api_gen.go ./swagger.yml
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/metric"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+var _ StrictServerInterface = Server{}
+
+type Server struct {
+	baseURL string
+	service metric.Service
+}
+
+func NewServer(host string, service metric.Service) Server {
+	return Server{
+		baseURL: host + "/open-insurance/admin/v1",
+		service: service,
+	}
+}
+
+func (s Server) Handler() (http.Handler, string) {
+	mux := http.NewServeMux()
+
+	swaggerMiddleware, swaggerVersion := middleware.Swagger(GetSwagger, func(err error) api.Error {
+		// A period outside the enum is well formed but cannot be processed.
+		var reqErr *openapi3filter.RequestError
+		if errors.As(err, &reqErr) && reqErr.Parameter != nil && reqErr.Parameter.Name == "period" {
+			return api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error())
+		}
+		return api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error())
+	})
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+			ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				writeResponseError(w, r, err)
+			},
+		}),
+		HandlerMiddlewares: []MiddlewareFunc{swaggerMiddleware},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		},
+	}
+
+	// The admin metrics are public, so neither authentication nor the FAPI
+	// interaction ID is required.
+	mux.HandleFunc("GET /metrics", wrapper.GetMetrics)
+
+	return middleware.StripPrefix("/open-insurance/admin/v1", mux, mux), swaggerVersion
+}
+
+func (s Server) GetMetrics(ctx context.Context, req GetMetricsRequestObject) (GetMetricsResponseObject, error) {
+	period := metric.PeriodAll
+	if req.Params.Period != nil {
+		period = metric.Period(*req.Params.Period)
+	}
+
+	report, err := s.service.Report(ctx, period)
+	if err != nil {
+		return nil, err
+	}
+
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	endpoints := page.Slice(report.Endpoints, pag)
+	resp := ResponseMetrics{
+		Data: MetricsData{
+			RequestTime:     timeutil.DateTimeNow(),
+			UptimeRate:      rate(report.UptimeRate()),
+			Invocations:     dailyCount(report.Days, func(d metric.Day) int { return d.Invocations }),
+			AverageResponse: dailyCount(report.Days, func(d metric.Day) int { return int(d.AverageResponseTime) }),
+			Errors:          dailyCount(report.Days, func(d metric.Day) int { return d.Errors }),
+			Rejections:      dailyCount(report.Days, func(d metric.Day) int { return d.Rejections }),
+			ActiveConsents:  report.ActiveConsents,
+			Endpoints:       []EndpointMetrics{},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/metrics", endpoints),
+		Meta:  *api.NewPaginatedMeta(endpoints),
+	}
+	for _, e := range endpoints.Records {
+		resp.Data.Endpoints = append(resp.Data.Endpoints, EndpointMetrics{
+			URL:         e.Endpoint,
+			Method:      e.Method,
+			Invocations: e.Invocations,
+			Errors:      e.Errors,
+			ErrorRate:   rate(e.ErrorRate()),
+			ResponseTime: ResponseTime{
+				Average: int(e.AverageResponseTime),
+				P50:     int(e.P50),
+				P90:     int(e.P90),
+				P99:     int(e.P99),
+			},
+		})
+	}
+
+	return GetMetrics200JSONResponse{OKResponseMetricsJSONResponse(resp)}, nil
+}
+
+// dailyCount splits the value of the current day from the previous ones.
+func dailyCount(days []metric.Day, value func(metric.Day) int) DailyCount {
+	count := DailyCount{PreviousDays: []int{}}
+	for i, d := range days {
+		if i == 0 {
+			count.CurrentDay = value(d)
+			continue
+		}
+		count.PreviousDays = append(count.PreviousDays, value(d))
+	}
+	return count
+}
+
+func rate(r float64) string {
+	return fmt.Sprintf("%.4f", r)
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
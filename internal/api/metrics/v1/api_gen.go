@@ -0,0 +1,740 @@
+//go:build go1.22
+
+// Package v1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Defines values for Period.
+const (
+	PeriodALL     Period = "ALL"
+	PeriodCURRENT Period = "CURRENT"
+)
+
+// Defines values for GetMetricsParamsPeriod.
+const (
+	GetMetricsParamsPeriodALL     GetMetricsParamsPeriod = "ALL"
+	GetMetricsParamsPeriodCURRENT GetMetricsParamsPeriod = "CURRENT"
+)
+
+// DailyCount Valores apurados por dia. Os dias seguem o horário de Brasília.
+// Para averageResponse, o valor é o tempo médio de resposta em milissegundos.
+// Para rejections, o valor é a quantidade de consentimentos rejeitados.
+type DailyCount struct {
+	// CurrentDay Valor apurado no dia atual.
+	CurrentDay int `json:"currentDay"`
+
+	// PreviousDays Valores apurados nos dias anteriores, do mais recente para o mais antigo. Vazio quando o período é CURRENT.
+	PreviousDays []int `json:"previousDays"`
+}
+
+// EndpointMetrics defines model for EndpointMetrics.
+type EndpointMetrics struct {
+	ErrorRate string `json:"errorRate"`
+
+	// Errors Quantidade de chamadas respondidas com status 4xx ou 5xx.
+	Errors      int    `json:"errors"`
+	Invocations int    `json:"invocations"`
+	Method      string `json:"method"`
+
+	// ResponseTime Tempos de resposta em milissegundos.
+	ResponseTime ResponseTime `json:"responseTime"`
+
+	// URL Endpoint com os parâmetros de caminho substituídos por {id}.
+	URL string `json:"url"`
+}
+
+// MetricsData defines model for MetricsData.
+type MetricsData struct {
+	// ActiveConsents Quantidade de consentimentos autorizados e não expirados.
+	ActiveConsents int `json:"activeConsents"`
+
+	// AverageResponse Valores apurados por dia. Os dias seguem o horário de Brasília.
+	// Para averageResponse, o valor é o tempo médio de resposta em milissegundos.
+	// Para rejections, o valor é a quantidade de consentimentos rejeitados.
+	AverageResponse DailyCount `json:"averageResponse"`
+
+	// Endpoints Métricas de cada endpoint no período solicitado. A paginação se aplica a esta lista.
+	Endpoints []EndpointMetrics `json:"endpoints"`
+
+	// Errors Valores apurados por dia. Os dias seguem o horário de Brasília.
+	// Para averageResponse, o valor é o tempo médio de resposta em milissegundos.
+	// Para rejections, o valor é a quantidade de consentimentos rejeitados.
+	Errors DailyCount `json:"errors"`
+
+	// Invocations Valores apurados por dia. Os dias seguem o horário de Brasília.
+	// Para averageResponse, o valor é o tempo médio de resposta em milissegundos.
+	// Para rejections, o valor é a quantidade de consentimentos rejeitados.
+	Invocations DailyCount `json:"invocations"`
+
+	// Rejections Valores apurados por dia. Os dias seguem o horário de Brasília.
+	// Para averageResponse, o valor é o tempo médio de resposta em milissegundos.
+	// Para rejections, o valor é a quantidade de consentimentos rejeitados.
+	Rejections DailyCount `json:"rejections"`
+
+	// RequestTime Data e hora em que as métricas foram calculadas.
+	RequestTime timeutil.DateTime `json:"requestTime"`
+
+	// UptimeRate Taxa de chamadas atendidas sem erro do servidor no período solicitado.
+	UptimeRate string `json:"uptimeRate"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Errors []struct {
+		// Code Código de erro específico do endpoint
+		Code string `json:"code"`
+
+		// Detail Descrição legível por humanos deste erro específico
+		Detail string `json:"detail"`
+
+		// RequestDateTime Data e hora da consulta, conforme especificação RFC-3339, formato UTC.
+		RequestDateTime timeutil.DateTime `json:"requestDateTime"`
+
+		// Title Título legível por humanos deste erro específico
+		Title string `json:"title"`
+	} `json:"errors"`
+	Meta *api.Meta `json:"meta,omitempty"`
+}
+
+// ResponseMetrics defines model for ResponseMetrics.
+type ResponseMetrics struct {
+	Data  MetricsData `json:"data"`
+	Links api.Links   `json:"links"`
+	Meta  api.Meta    `json:"meta"`
+}
+
+// ResponseTime Tempos de resposta em milissegundos.
+type ResponseTime struct {
+	// Average Tempo médio de resposta em milissegundos.
+	Average int `json:"average"`
+	P50     int `json:"p50"`
+	P90     int `json:"p90"`
+	P99     int `json:"p99"`
+}
+
+// Page defines model for page.
+type Page = int32
+
+// PageSize defines model for pageSize.
+type PageSize = int32
+
+// Period defines model for period.
+type Period string
+
+// XMinV defines model for x-min-v.
+type XMinV = string
+
+// XVHeader defines model for x-v.
+type XVHeader = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Default defines model for Default.
+type Default = ResponseError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ResponseError
+
+// MethodNotAllowed defines model for MethodNotAllowed.
+type MethodNotAllowed = ResponseError
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// OKResponseMetrics defines model for OKResponseMetrics.
+type OKResponseMetrics = ResponseMetrics
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ResponseError
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ResponseError
+
+// GetMetricsParams defines parameters for GetMetrics.
+type GetMetricsParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// Period Período das métricas (vide Enum):
+	// - CURRENT: apenas o dia atual.
+	// - ALL: o dia atual e os 7 dias anteriores.
+	Period *GetMetricsParamsPeriod `form:"period,omitempty" json:"period,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetMetricsParamsPeriod defines parameters for GetMetrics.
+type GetMetricsParamsPeriod string
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Obtém as métricas de uso das APIs da instituição.
+	// (GET /metrics)
+	GetMetrics(w http.ResponseWriter, r *http.Request, params GetMetricsParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetMetrics operation middleware
+func (siw *ServerInterfaceWrapper) GetMetrics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMetricsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "period" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "period", r.URL.Query(), &params.Period)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "period", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMetrics(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/metrics", wrapper.GetMetrics)
+
+	return m
+}
+
+type BadRequestApplicationJSONCharsetUTF8Response ResponseError
+
+type DefaultApplicationJSONCharsetUTF8Response ResponseError
+
+type InternalServerErrorApplicationJSONCharsetUTF8Response ResponseError
+
+type MethodNotAllowedApplicationJSONCharsetUTF8Response ResponseError
+
+type NotAcceptableApplicationJSONCharsetUTF8Response ResponseError
+
+type NotFoundApplicationJSONCharsetUTF8Response ResponseError
+
+type OKResponseMetricsJSONResponse ResponseMetrics
+
+type TooManyRequestsApplicationJSONCharsetUTF8Response ResponseError
+
+type UnprocessableEntityApplicationJSONCharsetUTF8Response ResponseError
+
+type GetMetricsRequestObject struct {
+	Params GetMetricsParams
+}
+
+type GetMetricsResponseObject interface {
+	VisitGetMetricsResponse(w http.ResponseWriter) error
+}
+
+type GetMetrics200JSONResponse struct{ OKResponseMetricsJSONResponse }
+
+func (response GetMetrics200JSONResponse) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics400ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics404ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics405ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics406ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics422ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics429ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetrics500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetMetrics500ApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMetricsdefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetMetricsdefaultApplicationJSONCharsetUTF8Response) VisitGetMetricsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Obtém as métricas de uso das APIs da instituição.
+	// (GET /metrics)
+	GetMetrics(ctx context.Context, request GetMetricsRequestObject) (GetMetricsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetMetrics operation middleware
+func (sh *strictHandler) GetMetrics(w http.ResponseWriter, r *http.Request, params GetMetricsParams) {
+	var request GetMetricsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMetrics(ctx, request.(GetMetricsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMetrics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMetricsResponseObject); ok {
+		if err := validResponse.VisitGetMetricsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9xaT28bxxX/Kg+bHJJiSS4pK7FZ9KDYSiJUtlRZdouaKvC4+0SOszOznpmlJRsE+h36",
+	"CdIeDB10CnLJdb9JP0nxZnfJXZKSqCSAgF4kcmffzPv7e3+GH4NYy0wrUs4Gw49BhgYlOTLVtwnx/4Rs",
+	"bETmhFbBMHhR/CLJaEgQsuLHiVAI73ICsq74ESypRIOhd7mwwmGC8IWGGaba+PeNkCTMkrC4gv6X3SAM",
+	"BO/8LidzGYSBQknBsDw+DGw8JYklH+eYpy4Y9sPgXBuJLhgGQrmdQRAGUighc+kX3WVG5RJNyATzeeg3",
+	"eyk+bJDnLzkqJxJMCJx2mEJCYGgirDPaQqZNza29jdGOFR9u4Hawu4ldvKjYjaLobu7JCJ2s835MprjW",
+	"CRvDgiyunBExWvhiJhKCfZXLL4cj1YGnr05O9l+cDgEzUmhBQyIQ0OWYdnl97/Bw2HwIBNrC1/zAAirH",
+	"pxuy3ZG6SQMlfxvFD/YOD4MwIMXivQkqXoLQPz9biGudEWripb3oSKE6s3VxX5OxxX80yOJaCYmQaCCV",
+	"ZFoox+61d3zQcD0NGaUa4lSQctSFI0jIkXLsitpCgvx3pBKasb1tplVCBmItAWFWH4TCAqYOweaZNt6h",
+	"STlDUPEI/GnWhZfsPGwE5D09+c9kwepUxD4OLCje8FwbksvNbAganHB5ik2uNvA0UrmEuPgpERPNHmod",
+	"utzCo+greKEd7MUxZQ7HKZVGoguUWcp6HXSjblSbbUqYkFnardZ003Cb7HGLLe5pgxuEHanfwwQLqOEN",
+	"6+VzbUBM2Kt1zrtp4/Gq8e5FZ8Z2iHFMxSdMp3qxtefNkoGRcga9QLGWGjC3pUBNu9/H6iO1pd31rzR6",
+	"v7tzs9FvNTjbe6I71ct/e/19Sc6OULJmyWeHbzA5oXc5WcffYq04uPgjZlkqYmQ/6b21Wv0R4ikaS+5P",
+	"uTvvPOZXlod/bug8GAaf9ZaJqFeu2t5Jddy+MZoZmIcrPrhXu1rxqVSzAImpx9oEQ9BSOMH5CJ0R49xp",
+	"C3psxARd8ZMR2oZg6S2C0pDhZaoxYQ9BZ3BWXLExGnQK4dXJYZe186wGtgcSmhdAKLIZGUy05+mAMVph",
+	"+pLMjExJ+lD8HcXaGMohl0DMqtIwQUfv8bLGB53zQylio60lMxPFJ81SPCc31ckL7fbSVL+n5OFEgFgr",
+	"m0uRaAN8PPtFTNaiAQ2G4txYjwQsJKddTsE+0usY9/KwJIsQfcAgqfGojBJmQqgpMu8NyCs5hUSck2Fs",
+	"87DkROZhiTMuVwcZGY4pXtLewrFWb3PlPDrFaDB2ZMg2dyF4dfpt53Glj291rh7SrrXtFhBd2Y0uhHXk",
+	"PbNGEsFYKknV1jz6c73/c+I6y94hxv35rvfdwPnzRW2nx1ysWu99NmenLCHgVOvnqC4rSLYP6G6agWmJ",
+	"yKxy6/E408KCzIVD2/RJTpnn2qCEc/JrCed371K59EWbkUKVxURV7uocNKRCCkcwSfW4rtrrZMBbxlp5",
+	"JFLO7y8AnVATURrzlcqM9hE9TmlfOeEuH9ArPQgy1jCzKiFfFGZlUDFHxS++xAeqG5UEW5kvBAIEK5TD",
+	"i7VFIOsQvCochiCXRYmATFtbXM8ohVodhisZoawzeaXHzFBZ7PgSoJKKhX6GIr18qvNKX0kiWCBMjw17",
+	"gBNkg+E5ppZWBX7NhRdZwCw3mFRNViKwC0e27DksTXLi4meqTfGjEV4V3xi0xXUqsDtSx2gQcEYGJ1Qr",
+	"OFzUdMUVa5Bkxq3CVVKS++KFVUESpEiF5UNUom29naG3FDOLtrUTt7eLDrG0COtDMDRo66k8kFTtUdaQ",
+	"/mMQ594Dn+Hlhgran1ApgTPisilrFnP93VaDGK03iHwozYTO7TO8tDcc1NS30na1twshqWptQ7FH7ox1",
+	"Uj1j+Se6C6/xg9BeHxyEy3gsruoes8X6m/4gisL+zm50FgbCkfTM3S6KxIuD8s2vF8toDF5WFei7XBhK",
+	"uJNs6HZFA8uuUo/ZprzvftWkNMD7Vpdt25E4eE/Q+TS+kC+IulE/4u4qQ+fIsLL/8Sbqn41G3dEo+fho",
+	"/nkQrtfXfjN76ySC/WyK0rcQVUOwRP2qC7i4YBzcvbho6XznLl8RaqZLXLMtYXbudjPpC7QWVfDd/mk5",
+	"zjgkNXFTHmhsELnuHE6FpG0B0787D4PcpOu6qs1ZNkmWvbX4tyQ/s/G1iBRqyvXY2HKfVVzXOPNRJPOW",
+	"woKezkh1hLK5QRVTrwpw25sNlp+ZrC3nIIrWJV1xUWZ9oba26hduEDaca0VRm/y48t9n6PCePoyxEzN6",
+	"Wkl0p/u1YQ5zp4344OGD6popEx5PWup8NLjLi1Zg+y53aKQZjp3K7hv4X9ZI3gF8vqycRDWwaln6dWEP",
+	"MuTRXpkoLQH6pA9YJs1UWIfdoIFdtzG6ijDzVfxqRv72Eq/E6/aEy3x2XzpfQtah2lYy+x0Q52WfSHmS",
+	"0ho9loVcjGmcp4xe7VAbRIN+J9rtDPqn0ePhTjSMor8Hjelogo467HOrobYaaNWQonrIFLkTafcZujJy",
+	"GusdITNtXDnQ5t2CiXDTfNyNteylufjhctaTOv6hRAAyPVE10716Wx/WecZf6xTQVsopXmALstFRBdiW",
+	"qjY40csy7wZ3bKkq6j558uSeqWUFfZqGbAmwikSrEdnApoYThasI0ozGTVDVrnx/RcL1nxaxdw/qWCcb",
+	"zPR0OUnzJiGbUVxcn4u4NcZc8b3d3aYN3oxG70ejv45G9uwPm3J7Qg7Fhnz1zH8rcSalSVV0awPTXKKv",
+	"x8i6db5W4+DR4/swUznAIipujeYEy6lHyl1CrBUHJZW8iHMRVxh58u3Tzs7OzpMQyqDV8Or06f9NkDvh",
+	"0k3xXVy7PP0thruPE62WuezLNWsLB1s37qYIXBbT/bIurL+tpyZJZUHRVDlmovucn/8umsZMlO1vU7oq",
+	"zm+Dj19XsSdVgXRb3mvWUvMwSIX6wW7SwaFf+B2V8GDq9lqpJa24uE33NWzco7s/5b7b3t5xr/XJVQba",
+	"EHpbd/Gtnnlwd8u8G7Uamf4WXfaTNslgdwuSJy2Sx3edsmKvWi8lvyUL5a7rVpv7YvFc14MsjL3bVBc5",
+	"3+kZ+4UqPvl706OMFBzUPY+frYgU/vvPf8F+E/F/JnYT34EFU+cyO+z13r9/353oWXdseja3lPWC9SHg",
+	"8YEvDQ05bRS2S0Se69nyynrv+MBy4hHKd2lVfqwmDxtZ7I7USH0GR0aQWkwPyxhB5cjydfZRfZ/mLxCz",
+	"4pdxKuJm3yImJLmj4f6mSmzlPXiTTU9cjkxwMaIKQd0wlOIZXKzHxo+ttrtJT0VMVQNUGWkvw3hKMOhG",
+	"G7WOfrmrzaRX0dre4cHT/Rcv9ztMs8xg3gJ7iRQKOpsVGYQBX1iW9ur7S+J5GHArzMAxDHaqe2NGHR+i",
+	"PbnE4Qm59VA9GrviSt7P1hy1fl7Mexwk7Ke0aKDC1u9R3mzG8uUrPb7YnIdbvFZeem/xqv8Jypbv+V+X",
+	"bPNu+VuJ+dnKfeogim7KV4v3euuXEPMweLQNZeO61pM8uptkcV/jCXbvJli7wPOEX211UuOmjKkGg7up",
+	"Ng3xPe2Tu2lXr0vmYbC7jRo33bR6+FtcCt9OX98e+1l6LiWay98QOg4nHBmBj/TgzKcA6zkrA6YdoC/r",
+	"/jMhODY6yf0+a0jDZQhnVYOJNsiFBkP9ypAM+cTerO89/uZjvtdSp3qCN540vc9RZwuJl4jJi8H8bP6/",
+	"AQCB66SIyyYAAA==",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
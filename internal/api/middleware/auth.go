@@ -10,6 +10,7 @@ import (
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/go-oidc/pkg/provider"
 	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/audit"
 	"github.com/luikyv/mock-insurer/internal/consent"
 	"github.com/luikyv/mock-insurer/internal/oidc"
 )
@@ -34,6 +35,13 @@ func AuthWithOptions(op *provider.Provider, grantType goidc.GrantType, _ *Option
 			ctx = context.WithValue(ctx, api.CtxKeySubject, tokenInfo.Subject)
 			ctx = context.WithValue(ctx, api.CtxKeyScopes, tokenInfo.Scopes)
 			ctx = context.WithValue(ctx, api.CtxKeyOrgID, tokenInfo.AdditionalTokenClaims[oidc.OrgIDKey])
+			// Identify the call for the audit trail as soon as the token is
+			// known, so calls refused below are recorded as well.
+			if call, ok := ctx.Value(api.CtxKeyAuditCall).(*audit.Call); ok {
+				call.ClientID = tokenInfo.ClientID
+				call.OrgID, _ = tokenInfo.AdditionalTokenClaims[oidc.OrgIDKey].(string)
+				call.ConsentID, _ = consent.IDFromScopes(tokenInfo.Scopes)
+			}
 
 			switch grantType {
 			case goidc.GrantClientCredentials:
@@ -0,0 +1,49 @@
+package middleware
+
+import (
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/discovery"
+)
+
+// Outage creates a middleware that makes the API families affected by an
+// ongoing scheduled outage respond with 503 until the outage ends.
+func Outage(discoveryService discovery.Service) func(http.Handler) http.Handler {
+	return OutageWithOptions(discoveryService, nil)
+}
+
+func OutageWithOptions(discoveryService discovery.Service, _ *Options) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			family := discovery.FamilyFromPath(r.URL.Path)
+			if family == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+			outage, err := discoveryService.ActiveOutage(ctx, family)
+			if err != nil {
+				// Availability must not depend on the outage lookup.
+				slog.ErrorContext(ctx, "could not check outages", "family", family, "error", err)
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			if outage == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			slog.InfoContext(ctx, "api family is under a scheduled outage", "family", family, "outage_id", outage.ID)
+			retryAfter := math.Ceil(time.Until(outage.EndsAt.Time).Seconds())
+			w.Header().Set("Retry-After", strconv.Itoa(max(int(retryAfter), 1)))
+			api.WriteError(w, r, api.NewError("SERVICE_UNAVAILABLE", http.StatusServiceUnavailable, "the api is under a scheduled outage: "+outage.Explanation))
+		})
+	}
+}
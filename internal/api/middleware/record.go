@@ -0,0 +1,103 @@
+package middleware
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/recording"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// Record writes every request served and its response to the recorder. Bodies
+// larger than recording.MaxBodyBytes are truncated in the recording, but are
+// still fully served.
+func Record(recorder *recording.Recorder) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := timeutil.DateTimeNow()
+
+			body, err := io.ReadAll(r.Body)
+			// Serve the body as read, followed by the read error if any, e.g. when
+			// the body exceeds the maximum size allowed.
+			r.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), errReader{err: err}), Closer: r.Body}
+
+			rec := &exchangeRecorder{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(rec, r)
+
+			exchange := &recording.Exchange{
+				InteractionID: r.Header.Get(HeaderXFAPIInteractionID),
+				StartedAt:     start,
+				Duration:      time.Since(start.Time).Milliseconds(),
+				Request: recording.Request{
+					Method: r.Method,
+					Host:   r.Host,
+					Path:   r.URL.Path,
+					Query:  r.URL.RawQuery,
+					Header: r.Header,
+				},
+				Response: recording.Response{
+					StatusCode:    rec.status,
+					Header:        w.Header(),
+					Body:          rec.body.String(),
+					BodyTruncated: rec.truncated,
+				},
+			}
+			exchange.Request.Body, exchange.Request.BodyTruncated = truncate(body)
+			if err := recorder.Record(exchange); err != nil {
+				slog.ErrorContext(r.Context(), "could not record exchange", "error", err)
+			}
+		})
+	}
+}
+
+func truncate(body []byte) (string, bool) {
+	if len(body) > recording.MaxBodyBytes {
+		return string(body[:recording.MaxBodyBytes]), true
+	}
+	return string(body), false
+}
+
+// exchangeRecorder captures the status code and the body of the response.
+type exchangeRecorder struct {
+	http.ResponseWriter
+	status    int
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (r *exchangeRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *exchangeRecorder) Write(b []byte) (int, error) {
+	n := min(len(b), recording.MaxBodyBytes-r.body.Len())
+	r.body.Write(b[:n])
+	if n < len(b) {
+		r.truncated = true
+	}
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *exchangeRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type errReader struct {
+	err error
+}
+
+func (r errReader) Read([]byte) (int, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+	return 0, io.EOF
+}
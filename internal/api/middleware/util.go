@@ -42,6 +42,41 @@ func CertCN(next http.Handler) http.Handler {
 	})
 }
 
+// Route records in the route of the request context the pattern of mux that
+// matches the request, or api.RouteUnmatched if there is none. prefix is the
+// part of the path stripped before the request reaches mux.
+func Route(mux *http.ServeMux, prefix string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if route, ok := r.Context().Value(api.CtxKeyRoute).(*api.Route); ok {
+				route.Pattern = api.RouteUnmatched
+				if _, pattern := mux.Handler(r); pattern != "" {
+					route.Pattern = prefix + patternPath(pattern)
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// StripPrefix serves handler, which routes requests with mux, under prefix as
+// http.StripPrefix does and records the route of the requests.
+func StripPrefix(prefix string, mux *http.ServeMux, handler http.Handler) http.Handler {
+	return http.StripPrefix(prefix, Route(mux, prefix)(handler))
+}
+
+// patternPath removes the method and the host from a mux pattern, e.g.
+// "GET auth.local/authorize" becomes "/authorize".
+func patternPath(pattern string) string {
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		pattern = path
+	}
+	if i := strings.Index(pattern, "/"); i > 0 {
+		pattern = pattern[i:]
+	}
+	return pattern
+}
+
 func FAPIID() func(http.Handler) http.Handler {
 	return FAPIIDWithOptions(nil)
 }
@@ -20,8 +20,24 @@ const (
 	CtxKeyInteractionID ContextKey = "interaction_id"
 	CtxKeyOrgID         ContextKey = "org_id"
 	CtxKeySessionID     ContextKey = "session_id"
+	// CtxKeyAuditCall holds the *audit.Call filled by the auth middleware for
+	// the request being served.
+	CtxKeyAuditCall ContextKey = "audit_call"
+	// CtxKeyRoute holds the *Route set by the route middleware for the request
+	// being served.
+	CtxKeyRoute ContextKey = "route"
 )
 
+// RouteUnmatched is the route of requests no handler is registered for.
+const RouteUnmatched = "unmatched"
+
+// Route identifies the handler that served a request by the pattern it was
+// registered with, e.g. /open-insurance/consents/v2/consents/{consentId}.
+// Unlike request paths, routes are bounded, so they can label metrics.
+type Route struct {
+	Pattern string
+}
+
 type Links struct {
 	First string `json:"first,omitempty"`
 	Last  string `json:"last,omitempty"`
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/luikyv/go-oidc/pkg/provider"
+	"github.com/luikyv/mock-insurer/internal/oidc"
 	"github.com/rs/cors"
 	"github.com/unrolled/secure"
 )
@@ -46,5 +47,5 @@ func (s Server) RegisterRoutes(mux *http.ServeMux) {
 		})
 	}
 
-	s.provider.RegisterRoutes(mux, autorizeMiddleware)
+	s.provider.RegisterRoutes(mux, autorizeMiddleware, oidc.RefreshTokenMiddleware)
 }
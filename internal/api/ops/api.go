@@ -0,0 +1,110 @@
+// Package ops implements the operations API serving the Prometheus metrics
+// and the health probes. Like the admin API, it is not part of the Open
+// Insurance specification and is served on its own listener.
+package ops
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	"github.com/luikyv/mock-insurer/internal/webhook"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "mockinsurer"
+
+type Server struct {
+	db       *sql.DB
+	registry *prometheus.Registry
+	requests *prometheus.HistogramVec
+	draining *atomic.Bool
+}
+
+func NewServer(db *sql.DB) Server {
+	requests := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of the HTTP requests by route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		collectors.NewDBStatsCollector(db, namespace),
+		requests,
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "quote_automations_pending",
+			Help:      "Number of quotes waiting for their automated status transitions.",
+		}, func() float64 { return float64(quote.PendingAutomations()) }),
+		prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "webhooks_pending",
+			Help:      "Number of webhook notifications being delivered.",
+		}, func() float64 { return float64(webhook.Pending()) }),
+	)
+
+	return Server{
+		db:       db,
+		registry: registry,
+		requests: requests,
+		draining: &atomic.Bool{},
+	}
+}
+
+func (s Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.Handle("GET /metrics", promhttp.HandlerFor(s.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("GET /healthz", s.healthHandler)
+	mux.HandleFunc("GET /readyz", s.readyHandler)
+
+	return mux
+}
+
+// Drain makes the readiness probe fail while the server shuts down.
+func (s Server) Drain() {
+	s.draining.Store(true)
+}
+
+// ObserveRequest records the duration of a request served by the route.
+func (s Server) ObserveRequest(route, method string, status int, duration time.Duration) {
+	s.requests.WithLabelValues(route, method, strconv.Itoa(status)).Observe(duration.Seconds())
+}
+
+// healthHandler reports the process is alive. It doesn't check dependencies,
+// so an unavailable database doesn't cause restarts.
+func (s Server) healthHandler(w http.ResponseWriter, _ *http.Request) {
+	api.WriteJSON(w, map[string]any{"status": "ok"}, http.StatusOK)
+}
+
+// readyHandler reports whether the server can handle requests, which requires
+// a reachable database and the server not shutting down.
+func (s Server) readyHandler(w http.ResponseWriter, r *http.Request) {
+	if s.draining.Load() {
+		api.WriteJSON(w, map[string]any{"status": "draining"}, http.StatusServiceUnavailable)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 2*time.Second)
+	defer cancel()
+
+	if err := s.db.PingContext(ctx); err != nil {
+		slog.ErrorContext(ctx, "database is not reachable", "error", err)
+		api.WriteJSON(w, map[string]any{"status": "unavailable"}, http.StatusServiceUnavailable)
+		return
+	}
+
+	api.WriteJSON(w, map[string]any{"status": "ok"}, http.StatusOK)
+}
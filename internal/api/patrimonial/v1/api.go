@@ -83,7 +83,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /insurance-patrimonial/{policyId}/claim", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/insurance-patrimonial/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/insurance-patrimonial/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) GetInsurancePatrimonial(ctx context.Context, req GetInsurancePatrimonialRequestObject) (GetInsurancePatrimonialResponseObject, error) {
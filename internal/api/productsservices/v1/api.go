@@ -0,0 +1,281 @@
+//go:generate go tool oapi-codegen -config=./config.yml -package=v1 -o=./api_gen.go ./swagger.yml
+package v1
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/api/middleware"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/opendata"
+	"github.com/luikyv/mock-insurer/internal/page"
+)
+
+var _ StrictServerInterface = Server{}
+
+type Server struct {
+	baseURL string
+	service opendata.Service
+}
+
+func NewServer(host string, service opendata.Service) Server {
+	return Server{
+		baseURL: host + "/open-insurance/products-services/v1",
+		service: service,
+	}
+}
+
+func (s Server) Handler() (http.Handler, string) {
+	mux := http.NewServeMux()
+
+	swaggerMiddleware, swaggerVersion := middleware.Swagger(GetSwagger, func(err error) api.Error {
+		return api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error())
+	})
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: NewStrictHandlerWithOptions(s, nil, StrictHTTPServerOptions{
+			ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+				writeResponseError(w, r, err)
+			},
+		}),
+		HandlerMiddlewares: []MiddlewareFunc{swaggerMiddleware},
+		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusBadRequest, err.Error()))
+		},
+	}
+
+	// Open data is public, so neither authentication nor the FAPI
+	// interaction ID is required.
+	mux.HandleFunc("GET /auto-insurance", wrapper.GetAutoInsurance)
+	mux.HandleFunc("GET /home-insurance", wrapper.GetHomeInsurance)
+	mux.HandleFunc("GET /life-pension", wrapper.GetLifePension)
+	mux.HandleFunc("GET /capitalization-title", wrapper.GetCapitalizationTitle)
+
+	return middleware.StripPrefix("/open-insurance/products-services/v1", mux, mux), swaggerVersion
+}
+
+func (s Server) GetAutoInsurance(ctx context.Context, req GetAutoInsuranceRequestObject) (GetAutoInsuranceResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	products, err := s.service.Products(ctx, opendata.ProductTypeAuto, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := AutoInsuranceCompany{
+		Name:       brand.CompanyName,
+		CnpjNumber: brand.CNPJ,
+		Products:   []AutoInsuranceProduct{},
+	}
+	for _, p := range products.Records {
+		product := AutoInsuranceProduct{
+			Name:               p.Data.Name,
+			Code:               p.Code,
+			Coverages:          []AutoInsuranceCoverage{},
+			AssistanceServices: toAssistanceServices(p.Data.AssistanceServices),
+			TermsAndConditions: []TermsAndConditions{toTermsAndConditions(p)},
+			TargetAudiences:    toTargetAudiences(p.Data.TargetAudiences),
+		}
+		for _, c := range p.Data.Coverages {
+			product.Coverages = append(product.Coverages, AutoInsuranceCoverage{
+				Coverage:       c.Type,
+				CoverageDetail: c.Description,
+			})
+		}
+		company.Products = append(company.Products, product)
+	}
+
+	resp := ResponseAutoInsuranceList{
+		Data: AutoInsuranceData{
+			Brand: AutoInsuranceBrand{
+				Name:      brand.Name,
+				Companies: []AutoInsuranceCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/auto-insurance", products),
+		Meta:  *api.NewPaginatedMeta(products),
+	}
+	return GetAutoInsurance200JSONResponse{OKResponseAutoInsuranceListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetHomeInsurance(ctx context.Context, req GetHomeInsuranceRequestObject) (GetHomeInsuranceResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	products, err := s.service.Products(ctx, opendata.ProductTypeHome, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := HomeInsuranceCompany{
+		Name:       brand.CompanyName,
+		CnpjNumber: brand.CNPJ,
+		Products:   []HomeInsuranceProduct{},
+	}
+	for _, p := range products.Records {
+		product := HomeInsuranceProduct{
+			Name:               p.Data.Name,
+			Code:               p.Code,
+			Coverages:          []HomeInsuranceCoverage{},
+			AssistanceServices: toAssistanceServices(p.Data.AssistanceServices),
+			TermsAndConditions: toTermsAndConditions(p),
+			TargetAudiences:    toTargetAudiences(p.Data.TargetAudiences),
+		}
+		for _, c := range p.Data.Coverages {
+			product.Coverages = append(product.Coverages, HomeInsuranceCoverage{
+				CoverageType:   c.Type,
+				CoverageDetail: c.Description,
+			})
+		}
+		company.Products = append(company.Products, product)
+	}
+
+	resp := ResponseHomeInsuranceList{
+		Data: HomeInsuranceData{
+			Brand: HomeInsuranceBrand{
+				Name:      brand.Name,
+				Companies: []HomeInsuranceCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/home-insurance", products),
+		Meta:  *api.NewPaginatedMeta(products),
+	}
+	return GetHomeInsurance200JSONResponse{OKResponseHomeInsuranceListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetLifePension(ctx context.Context, req GetLifePensionRequestObject) (GetLifePensionResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	products, err := s.service.Products(ctx, opendata.ProductTypeLifePension, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := LifePensionCompany{
+		Name:       brand.CompanyName,
+		CnpjNumber: brand.CNPJ,
+		Products:   []LifePensionProduct{},
+	}
+	for _, p := range products.Records {
+		product := LifePensionProduct{
+			Name: p.Data.Name,
+			Code: p.Code,
+			ProductDetails: LifePensionProductDetails{
+				SusepProcessNumber:      p.Data.SusepProcessNumber,
+				ContractTermsConditions: p.Data.TermsURL,
+			},
+			TargetAudiences: toTargetAudiences(p.Data.TargetAudiences),
+		}
+		if p.Data.MinimumAmount != nil || p.Data.TermMonths != nil {
+			product.ProductDetails.DefferalPeriod = &LifePensionDefferalPeriod{
+				MinimumPremiumAmount:  p.Data.MinimumAmount,
+				GracePeriodRedemption: p.Data.TermMonths,
+			}
+		}
+		if len(p.Data.Coverages) != 0 {
+			coverages := make([]LifePensionCoverage, 0, len(p.Data.Coverages))
+			for _, c := range p.Data.Coverages {
+				coverages = append(coverages, LifePensionCoverage{
+					Coverage:       c.Type,
+					CoverageDetail: c.Description,
+				})
+			}
+			product.Coverages = &coverages
+		}
+		company.Products = append(company.Products, product)
+	}
+
+	resp := ResponseLifePensionList{
+		Data: LifePensionData{
+			Brand: LifePensionBrand{
+				Name:      brand.Name,
+				Companies: []LifePensionCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/life-pension", products),
+		Meta:  *api.NewPaginatedMeta(products),
+	}
+	return GetLifePension200JSONResponse{OKResponseLifePensionListJSONResponse(resp)}, nil
+}
+
+func (s Server) GetCapitalizationTitle(ctx context.Context, req GetCapitalizationTitleRequestObject) (GetCapitalizationTitleResponseObject, error) {
+	pag := page.NewPagination(req.Params.Page, req.Params.PageSize)
+	products, err := s.service.Products(ctx, opendata.ProductTypeCapitalizationTitle, pag)
+	if err != nil {
+		return nil, err
+	}
+
+	brand := s.service.Brand()
+	company := CapitalizationTitleCompany{
+		Name:       brand.CompanyName,
+		CnpjNumber: brand.CNPJ,
+		Products:   []CapitalizationTitleProduct{},
+	}
+	for _, p := range products.Records {
+		product := CapitalizationTitleProduct{
+			Name: p.Data.Name,
+			Code: p.Code,
+			TermsAndConditions: CapitalizationTitleTermsAndConditions{
+				SusepProcessNumber: p.Data.SusepProcessNumber,
+				GeneralConditions:  p.Data.TermsURL,
+			},
+			Validity:        p.Data.TermMonths,
+			TargetAudiences: toTargetAudiences(p.Data.TargetAudiences),
+		}
+		if p.Data.MinimumAmount != nil {
+			product.ContributionAmount = &CapitalizationTitleContributionAmount{
+				MinValue: p.Data.MinimumAmount,
+			}
+		}
+		company.Products = append(company.Products, product)
+	}
+
+	resp := ResponseCapitalizationTitleList{
+		Data: CapitalizationTitleData{
+			Brand: CapitalizationTitleBrand{
+				Name:      brand.Name,
+				Companies: []CapitalizationTitleCompany{company},
+			},
+		},
+		Links: *api.NewPaginatedLinks(s.baseURL+"/capitalization-title", products),
+		Meta:  *api.NewPaginatedMeta(products),
+	}
+	return GetCapitalizationTitle200JSONResponse{OKResponseCapitalizationTitleListJSONResponse(resp)}, nil
+}
+
+func toAssistanceServices(services []string) *[]AssistanceService {
+	if len(services) == 0 {
+		return nil
+	}
+
+	result := make([]AssistanceService, 0, len(services))
+	for _, service := range services {
+		result = append(result, AssistanceService{AssistanceServicesDetail: service})
+	}
+	return &result
+}
+
+func toTermsAndConditions(p *opendata.Product) TermsAndConditions {
+	return TermsAndConditions{
+		SusepProcessNumber: p.Data.SusepProcessNumber,
+		Definition:         p.Data.TermsURL,
+	}
+}
+
+func toTargetAudiences(audiences []opendata.TargetAudience) TargetAudiences {
+	result := make(TargetAudiences, 0, len(audiences))
+	for _, audience := range audiences {
+		result = append(result, string(audience))
+	}
+	return result
+}
+
+func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.As(err, &errorutil.Error{}) {
+		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	api.WriteError(w, r, err)
+}
@@ -0,0 +1,1657 @@
+//go:build go1.22
+
+// Package v1 provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package v1
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/luikyv/mock-insurer/internal/api"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/oapi-codegen/runtime"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// AssistanceService defines model for AssistanceService.
+type AssistanceService struct {
+	// AssistanceServicesDetail Serviço de assistência oferecido com o produto.
+	AssistanceServicesDetail string `json:"assistanceServicesDetail"`
+}
+
+// AutoInsuranceBrand defines model for AutoInsuranceBrand.
+type AutoInsuranceBrand struct {
+	Companies []AutoInsuranceCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// AutoInsuranceCompany defines model for AutoInsuranceCompany.
+type AutoInsuranceCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// Products Lista de produtos de seguro de automóveis da sociedade.
+	Products []AutoInsuranceProduct `json:"products"`
+}
+
+// AutoInsuranceCoverage defines model for AutoInsuranceCoverage.
+type AutoInsuranceCoverage struct {
+	// Coverage Cobertura do seguro de automóvel.
+	Coverage string `json:"coverage"`
+
+	// CoverageDetail Detalhamento da cobertura.
+	CoverageDetail string `json:"coverageDetail"`
+
+	// CoveragePermissionSeparteAcquisition Indica se a cobertura pode ser contratada isoladamente.
+	CoveragePermissionSeparteAcquisition *bool `json:"coveragePermissionSeparteAcquisition,omitempty"`
+}
+
+// AutoInsuranceData defines model for AutoInsuranceData.
+type AutoInsuranceData struct {
+	Brand AutoInsuranceBrand `json:"brand"`
+}
+
+// AutoInsuranceProduct defines model for AutoInsuranceProduct.
+type AutoInsuranceProduct struct {
+	AssistanceServices *[]AssistanceService `json:"assistanceServices,omitempty"`
+
+	// Code Código único do produto na sociedade.
+	Code      string                  `json:"code"`
+	Coverages []AutoInsuranceCoverage `json:"coverages"`
+
+	// Name Nome comercial do produto.
+	Name string `json:"name"`
+
+	// TargetAudiences Público alvo do produto.
+	TargetAudiences    TargetAudiences      `json:"targetAudiences"`
+	TermsAndConditions []TermsAndConditions `json:"termsAndConditions"`
+}
+
+// CapitalizationTitleBrand defines model for CapitalizationTitleBrand.
+type CapitalizationTitleBrand struct {
+	Companies []CapitalizationTitleCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// CapitalizationTitleCompany defines model for CapitalizationTitleCompany.
+type CapitalizationTitleCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// Products Lista de títulos de capitalização da sociedade.
+	Products []CapitalizationTitleProduct `json:"products"`
+}
+
+// CapitalizationTitleContributionAmount defines model for CapitalizationTitleContributionAmount.
+type CapitalizationTitleContributionAmount struct {
+	// MinValue Valor mínimo do título, em reais.
+	MinValue *string `json:"minValue,omitempty"`
+}
+
+// CapitalizationTitleData defines model for CapitalizationTitleData.
+type CapitalizationTitleData struct {
+	Brand CapitalizationTitleBrand `json:"brand"`
+}
+
+// CapitalizationTitleProduct defines model for CapitalizationTitleProduct.
+type CapitalizationTitleProduct struct {
+	// Code Código único do produto na sociedade.
+	Code               string                                 `json:"code"`
+	ContributionAmount *CapitalizationTitleContributionAmount `json:"contributionAmount,omitempty"`
+
+	// Name Nome comercial do produto.
+	Name string `json:"name"`
+
+	// TargetAudiences Público alvo do produto.
+	TargetAudiences    TargetAudiences                       `json:"targetAudiences"`
+	TermsAndConditions CapitalizationTitleTermsAndConditions `json:"termsAndConditions"`
+
+	// Validity Prazo de vigência do título, em meses.
+	Validity *int `json:"validity,omitempty"`
+}
+
+// CapitalizationTitleTermsAndConditions defines model for CapitalizationTitleTermsAndConditions.
+type CapitalizationTitleTermsAndConditions struct {
+	// GeneralConditions URL com as condições gerais do título.
+	GeneralConditions string `json:"generalConditions"`
+
+	// SusepProcessNumber Número do processo SUSEP do produto.
+	SusepProcessNumber string `json:"susepProcessNumber"`
+}
+
+// HomeInsuranceBrand defines model for HomeInsuranceBrand.
+type HomeInsuranceBrand struct {
+	Companies []HomeInsuranceCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// HomeInsuranceCompany defines model for HomeInsuranceCompany.
+type HomeInsuranceCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// Products Lista de produtos de seguro residencial da sociedade.
+	Products []HomeInsuranceProduct `json:"products"`
+}
+
+// HomeInsuranceCoverage defines model for HomeInsuranceCoverage.
+type HomeInsuranceCoverage struct {
+	// CoverageDetail Detalhamento da cobertura.
+	CoverageDetail string `json:"coverageDetail"`
+
+	// CoveragePermissionSeparteAcquisition Indica se a cobertura pode ser contratada isoladamente.
+	CoveragePermissionSeparteAcquisition *bool `json:"coveragePermissionSeparteAcquisition,omitempty"`
+
+	// CoverageType Cobertura do seguro residencial.
+	CoverageType string `json:"coverageType"`
+}
+
+// HomeInsuranceData defines model for HomeInsuranceData.
+type HomeInsuranceData struct {
+	Brand HomeInsuranceBrand `json:"brand"`
+}
+
+// HomeInsuranceProduct defines model for HomeInsuranceProduct.
+type HomeInsuranceProduct struct {
+	AssistanceServices *[]AssistanceService `json:"assistanceServices,omitempty"`
+
+	// Code Código único do produto na sociedade.
+	Code      string                  `json:"code"`
+	Coverages []HomeInsuranceCoverage `json:"coverages"`
+
+	// Name Nome comercial do produto.
+	Name string `json:"name"`
+
+	// TargetAudiences Público alvo do produto.
+	TargetAudiences    TargetAudiences    `json:"targetAudiences"`
+	TermsAndConditions TermsAndConditions `json:"termsAndConditions"`
+}
+
+// LifePensionBrand defines model for LifePensionBrand.
+type LifePensionBrand struct {
+	Companies []LifePensionCompany `json:"companies"`
+
+	// Name Nome da marca reportada pelo participante do Open Insurance.
+	Name string `json:"name"`
+}
+
+// LifePensionCompany defines model for LifePensionCompany.
+type LifePensionCompany struct {
+	// CnpjNumber CNPJ da sociedade pertencente à marca.
+	CnpjNumber string `json:"cnpjNumber"`
+
+	// Name Nome da sociedade pertencente à marca.
+	Name string `json:"name"`
+
+	// Products Lista de planos de previdência da sociedade.
+	Products []LifePensionProduct `json:"products"`
+}
+
+// LifePensionCoverage defines model for LifePensionCoverage.
+type LifePensionCoverage struct {
+	// Coverage Cobertura do plano.
+	Coverage string `json:"coverage"`
+
+	// CoverageDetail Detalhamento da cobertura.
+	CoverageDetail string `json:"coverageDetail"`
+}
+
+// LifePensionData defines model for LifePensionData.
+type LifePensionData struct {
+	Brand LifePensionBrand `json:"brand"`
+}
+
+// LifePensionDefferalPeriod Condições do período de diferimento, ou acumulação, do plano.
+type LifePensionDefferalPeriod struct {
+	// GracePeriodRedemption Prazo de carência para o resgate, em meses.
+	GracePeriodRedemption *int `json:"gracePeriodRedemption,omitempty"`
+
+	// MinimumPremiumAmount Valor mínimo da contribuição, em reais.
+	MinimumPremiumAmount *string `json:"minimumPremiumAmount,omitempty"`
+}
+
+// LifePensionProduct defines model for LifePensionProduct.
+type LifePensionProduct struct {
+	// Code Código único do produto na sociedade.
+	Code      string                 `json:"code"`
+	Coverages *[]LifePensionCoverage `json:"coverages,omitempty"`
+
+	// Name Nome comercial do produto.
+	Name           string                    `json:"name"`
+	ProductDetails LifePensionProductDetails `json:"productDetails"`
+
+	// TargetAudiences Público alvo do produto.
+	TargetAudiences TargetAudiences `json:"targetAudiences"`
+}
+
+// LifePensionProductDetails defines model for LifePensionProductDetails.
+type LifePensionProductDetails struct {
+	// ContractTermsConditions URL com o regulamento do plano.
+	ContractTermsConditions string `json:"contractTermsConditions"`
+
+	// DefferalPeriod Condições do período de diferimento, ou acumulação, do plano.
+	DefferalPeriod *LifePensionDefferalPeriod `json:"defferalPeriod,omitempty"`
+
+	// SusepProcessNumber Número do processo SUSEP do produto.
+	SusepProcessNumber string `json:"susepProcessNumber"`
+}
+
+// ResponseAutoInsuranceList defines model for ResponseAutoInsuranceList.
+type ResponseAutoInsuranceList struct {
+	Data  AutoInsuranceData `json:"data"`
+	Links api.Links         `json:"links"`
+	Meta  api.Meta          `json:"meta"`
+}
+
+// ResponseCapitalizationTitleList defines model for ResponseCapitalizationTitleList.
+type ResponseCapitalizationTitleList struct {
+	Data  CapitalizationTitleData `json:"data"`
+	Links api.Links               `json:"links"`
+	Meta  api.Meta                `json:"meta"`
+}
+
+// ResponseError defines model for ResponseError.
+type ResponseError struct {
+	Errors []struct {
+		// Code Código de erro específico do endpoint
+		Code string `json:"code"`
+
+		// Detail Descrição legível por humanos deste erro específico
+		Detail string `json:"detail"`
+
+		// RequestDateTime Data e hora da consulta, conforme especificação RFC-3339, formato UTC.
+		RequestDateTime timeutil.DateTime `json:"requestDateTime"`
+
+		// Title Título legível por humanos deste erro específico
+		Title string `json:"title"`
+	} `json:"errors"`
+	Meta *api.Meta `json:"meta,omitempty"`
+}
+
+// ResponseHomeInsuranceList defines model for ResponseHomeInsuranceList.
+type ResponseHomeInsuranceList struct {
+	Data  HomeInsuranceData `json:"data"`
+	Links api.Links         `json:"links"`
+	Meta  api.Meta          `json:"meta"`
+}
+
+// ResponseLifePensionList defines model for ResponseLifePensionList.
+type ResponseLifePensionList struct {
+	Data  LifePensionData `json:"data"`
+	Links api.Links       `json:"links"`
+	Meta  api.Meta        `json:"meta"`
+}
+
+// TargetAudiences Público alvo do produto.
+type TargetAudiences = []string
+
+// TermsAndConditions defines model for TermsAndConditions.
+type TermsAndConditions struct {
+	// Definition URL com as condições gerais do produto.
+	Definition string `json:"definition"`
+
+	// SusepProcessNumber Número do processo SUSEP do produto.
+	SusepProcessNumber string `json:"susepProcessNumber"`
+}
+
+// Page defines model for page.
+type Page = int32
+
+// PageSize defines model for pageSize.
+type PageSize = int32
+
+// XMinV defines model for x-min-v.
+type XMinV = string
+
+// XVHeader defines model for x-v.
+type XVHeader = string
+
+// BadRequest defines model for BadRequest.
+type BadRequest = ResponseError
+
+// Default defines model for Default.
+type Default = ResponseError
+
+// InternalServerError defines model for InternalServerError.
+type InternalServerError = ResponseError
+
+// MethodNotAllowed defines model for MethodNotAllowed.
+type MethodNotAllowed = ResponseError
+
+// NotAcceptable defines model for NotAcceptable.
+type NotAcceptable = ResponseError
+
+// NotFound defines model for NotFound.
+type NotFound = ResponseError
+
+// OKResponseAutoInsuranceList defines model for OKResponseAutoInsuranceList.
+type OKResponseAutoInsuranceList = ResponseAutoInsuranceList
+
+// OKResponseCapitalizationTitleList defines model for OKResponseCapitalizationTitleList.
+type OKResponseCapitalizationTitleList = ResponseCapitalizationTitleList
+
+// OKResponseHomeInsuranceList defines model for OKResponseHomeInsuranceList.
+type OKResponseHomeInsuranceList = ResponseHomeInsuranceList
+
+// OKResponseLifePensionList defines model for OKResponseLifePensionList.
+type OKResponseLifePensionList = ResponseLifePensionList
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ResponseError
+
+// UnprocessableEntity defines model for UnprocessableEntity.
+type UnprocessableEntity = ResponseError
+
+// GetAutoInsuranceParams defines parameters for GetAutoInsurance.
+type GetAutoInsuranceParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetCapitalizationTitleParams defines parameters for GetCapitalizationTitle.
+type GetCapitalizationTitleParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetHomeInsuranceParams defines parameters for GetHomeInsurance.
+type GetHomeInsuranceParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// GetLifePensionParams defines parameters for GetLifePension.
+type GetLifePensionParams struct {
+	// Page Número da página que está sendo requisitada (o valor da primeira página é 1).
+	Page *Page `form:"page,omitempty" json:"page,omitempty"`
+
+	// PageSize Quantidade total de registros por páginas.
+	PageSize *PageSize `form:"page-size,omitempty" json:"page-size,omitempty"`
+
+	// XVHeader Versão do endpoint da API requisitado pelo cliente. O titular dos dados deve
+	// responder com a versão mais alta suportada entre x-min-v e x-v. Se o valor de
+	// x-min-v for igual ou maior que o valor de x-v, o cabeçalho x-min-v deve ser
+	// tratado como ausente. Se todas as versões solicitadas não forem suportadas,
+	// o titular dos dados deve responder com o código de status 406 Not Acceptable.
+	XVHeader *XVHeader `json:"x-v,omitempty"`
+
+	// XMinV Versão mínima do endpoint da API requisitado pelo cliente. O detentor dos dados
+	// deve responder com a versão mais alta suportada entre x-min-v e x-v. Se todas as
+	// versões solicitadas não forem suportadas, o titular dos dados deve responder com
+	// um código de status 406 Not Acceptable.
+	XMinV *XMinV `json:"x-min-v,omitempty"`
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Obtém a lista de produtos de seguro de automóveis.
+	// (GET /auto-insurance)
+	GetAutoInsurance(w http.ResponseWriter, r *http.Request, params GetAutoInsuranceParams)
+	// Obtém a lista de produtos de títulos de capitalização.
+	// (GET /capitalization-title)
+	GetCapitalizationTitle(w http.ResponseWriter, r *http.Request, params GetCapitalizationTitleParams)
+	// Obtém a lista de produtos de seguro residencial.
+	// (GET /home-insurance)
+	GetHomeInsurance(w http.ResponseWriter, r *http.Request, params GetHomeInsuranceParams)
+	// Obtém a lista de produtos de previdência sobrevivência.
+	// (GET /life-pension)
+	GetLifePension(w http.ResponseWriter, r *http.Request, params GetLifePensionParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAutoInsurance operation middleware
+func (siw *ServerInterfaceWrapper) GetAutoInsurance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAutoInsuranceParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAutoInsurance(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCapitalizationTitle operation middleware
+func (siw *ServerInterfaceWrapper) GetCapitalizationTitle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCapitalizationTitleParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapitalizationTitle(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHomeInsurance operation middleware
+func (siw *ServerInterfaceWrapper) GetHomeInsurance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHomeInsuranceParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHomeInsurance(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetLifePension operation middleware
+func (siw *ServerInterfaceWrapper) GetLifePension(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLifePensionParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page-size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page-size", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "x-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-v")]; found {
+		var XVHeader XVHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-v", valueList[0], &XVHeader, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-v", Err: err})
+			return
+		}
+
+		params.XVHeader = &XVHeader
+
+	}
+
+	// ------------- Optional header parameter "x-min-v" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("x-min-v")]; found {
+		var XMinV XMinV
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "x-min-v", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "x-min-v", valueList[0], &XMinV, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "x-min-v", Err: err})
+			return
+		}
+
+		params.XMinV = &XMinV
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetLifePension(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/auto-insurance", wrapper.GetAutoInsurance)
+	m.HandleFunc("GET "+options.BaseURL+"/capitalization-title", wrapper.GetCapitalizationTitle)
+	m.HandleFunc("GET "+options.BaseURL+"/home-insurance", wrapper.GetHomeInsurance)
+	m.HandleFunc("GET "+options.BaseURL+"/life-pension", wrapper.GetLifePension)
+
+	return m
+}
+
+type BadRequestApplicationJSONCharsetUTF8Response ResponseError
+
+type DefaultApplicationJSONCharsetUTF8Response ResponseError
+
+type InternalServerErrorApplicationJSONCharsetUTF8Response ResponseError
+
+type MethodNotAllowedApplicationJSONCharsetUTF8Response ResponseError
+
+type NotAcceptableApplicationJSONCharsetUTF8Response ResponseError
+
+type NotFoundApplicationJSONCharsetUTF8Response ResponseError
+
+type OKResponseAutoInsuranceListJSONResponse ResponseAutoInsuranceList
+
+type OKResponseCapitalizationTitleListJSONResponse ResponseCapitalizationTitleList
+
+type OKResponseHomeInsuranceListJSONResponse ResponseHomeInsuranceList
+
+type OKResponseLifePensionListJSONResponse ResponseLifePensionList
+
+type TooManyRequestsApplicationJSONCharsetUTF8Response ResponseError
+
+type UnprocessableEntityApplicationJSONCharsetUTF8Response ResponseError
+
+type GetAutoInsuranceRequestObject struct {
+	Params GetAutoInsuranceParams
+}
+
+type GetAutoInsuranceResponseObject interface {
+	VisitGetAutoInsuranceResponse(w http.ResponseWriter) error
+}
+
+type GetAutoInsurance200JSONResponse struct {
+	OKResponseAutoInsuranceListJSONResponse
+}
+
+func (response GetAutoInsurance200JSONResponse) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance400ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance404ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance405ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance406ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance422ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance429ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurance500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetAutoInsurance500ApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAutoInsurancedefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetAutoInsurancedefaultApplicationJSONCharsetUTF8Response) VisitGetAutoInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCapitalizationTitleRequestObject struct {
+	Params GetCapitalizationTitleParams
+}
+
+type GetCapitalizationTitleResponseObject interface {
+	VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error
+}
+
+type GetCapitalizationTitle200JSONResponse struct {
+	OKResponseCapitalizationTitleListJSONResponse
+}
+
+func (response GetCapitalizationTitle200JSONResponse) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle400ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle404ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle405ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle406ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle422ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle429ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitle500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetCapitalizationTitle500ApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapitalizationTitledefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetCapitalizationTitledefaultApplicationJSONCharsetUTF8Response) VisitGetCapitalizationTitleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetHomeInsuranceRequestObject struct {
+	Params GetHomeInsuranceParams
+}
+
+type GetHomeInsuranceResponseObject interface {
+	VisitGetHomeInsuranceResponse(w http.ResponseWriter) error
+}
+
+type GetHomeInsurance200JSONResponse struct {
+	OKResponseHomeInsuranceListJSONResponse
+}
+
+func (response GetHomeInsurance200JSONResponse) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance400ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance404ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance405ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance406ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance422ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance429ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurance500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetHomeInsurance500ApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHomeInsurancedefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetHomeInsurancedefaultApplicationJSONCharsetUTF8Response) VisitGetHomeInsuranceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetLifePensionRequestObject struct {
+	Params GetLifePensionParams
+}
+
+type GetLifePensionResponseObject interface {
+	VisitGetLifePensionResponse(w http.ResponseWriter) error
+}
+
+type GetLifePension200JSONResponse struct {
+	OKResponseLifePensionListJSONResponse
+}
+
+func (response GetLifePension200JSONResponse) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension400ApplicationJSONCharsetUTF8Response struct {
+	BadRequestApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension400ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension404ApplicationJSONCharsetUTF8Response struct {
+	NotFoundApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension404ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension405ApplicationJSONCharsetUTF8Response struct {
+	MethodNotAllowedApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension405ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(405)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension406ApplicationJSONCharsetUTF8Response struct {
+	NotAcceptableApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension406ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(406)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension422ApplicationJSONCharsetUTF8Response struct {
+	UnprocessableEntityApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension422ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension429ApplicationJSONCharsetUTF8Response struct {
+	TooManyRequestsApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension429ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePension500ApplicationJSONCharsetUTF8Response struct {
+	InternalServerErrorApplicationJSONCharsetUTF8Response
+}
+
+func (response GetLifePension500ApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetLifePensiondefaultApplicationJSONCharsetUTF8Response struct {
+	Body       ResponseError
+	StatusCode int
+}
+
+func (response GetLifePensiondefaultApplicationJSONCharsetUTF8Response) VisitGetLifePensionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(response.StatusCode)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Obtém a lista de produtos de seguro de automóveis.
+	// (GET /auto-insurance)
+	GetAutoInsurance(ctx context.Context, request GetAutoInsuranceRequestObject) (GetAutoInsuranceResponseObject, error)
+	// Obtém a lista de produtos de títulos de capitalização.
+	// (GET /capitalization-title)
+	GetCapitalizationTitle(ctx context.Context, request GetCapitalizationTitleRequestObject) (GetCapitalizationTitleResponseObject, error)
+	// Obtém a lista de produtos de seguro residencial.
+	// (GET /home-insurance)
+	GetHomeInsurance(ctx context.Context, request GetHomeInsuranceRequestObject) (GetHomeInsuranceResponseObject, error)
+	// Obtém a lista de produtos de previdência sobrevivência.
+	// (GET /life-pension)
+	GetLifePension(ctx context.Context, request GetLifePensionRequestObject) (GetLifePensionResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAutoInsurance operation middleware
+func (sh *strictHandler) GetAutoInsurance(w http.ResponseWriter, r *http.Request, params GetAutoInsuranceParams) {
+	var request GetAutoInsuranceRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAutoInsurance(ctx, request.(GetAutoInsuranceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAutoInsurance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAutoInsuranceResponseObject); ok {
+		if err := validResponse.VisitGetAutoInsuranceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCapitalizationTitle operation middleware
+func (sh *strictHandler) GetCapitalizationTitle(w http.ResponseWriter, r *http.Request, params GetCapitalizationTitleParams) {
+	var request GetCapitalizationTitleRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapitalizationTitle(ctx, request.(GetCapitalizationTitleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapitalizationTitle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapitalizationTitleResponseObject); ok {
+		if err := validResponse.VisitGetCapitalizationTitleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHomeInsurance operation middleware
+func (sh *strictHandler) GetHomeInsurance(w http.ResponseWriter, r *http.Request, params GetHomeInsuranceParams) {
+	var request GetHomeInsuranceRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHomeInsurance(ctx, request.(GetHomeInsuranceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHomeInsurance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHomeInsuranceResponseObject); ok {
+		if err := validResponse.VisitGetHomeInsuranceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetLifePension operation middleware
+func (sh *strictHandler) GetLifePension(w http.ResponseWriter, r *http.Request, params GetLifePensionParams) {
+	var request GetLifePensionRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetLifePension(ctx, request.(GetLifePensionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetLifePension")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetLifePensionResponseObject); ok {
+		if err := validResponse.VisitGetLifePensionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+xc23IbOXp+FVTvXiSp5kGU5HiUygUt0TPcyBJXlJytDB3Xz+6fJDzdQA+ApqWZYlXe",
+	"IS+QrVxMzVb5ampv5pZvkidJAegm+8STLFq7I/nCItkN4D//H34cfnQ8HkacIVPSOfnRiUBAiApF8m2M",
+	"+q+P0hM0UpQz58S5mP8aouDEBxLN/zymDMj3MRKUav5nIpH5nAj8PqaSKvCB/AMnUwi4MO8LGiIVy4bz",
+	"n8nBP9Yd16G65+9jFHeO6zAI0Tmxw7uO9CYYgqVjBHGgnJMD1xlxEYJyThzK1GHLcZ2QMhrGoXmo7iK0",
+	"j3CMwpnNXNNZn/5Qwc8fY2CK+uAjUVxBQHwkAsdUKsElibhIqZXrCK1J+sMKalvHVeTCbUJus9ncSP1t",
+	"LaSsNi0T/xaFnP8vJ+H8E6MhEJ8TZH7EKVNa4O1eN6MMTiIMOPECikxhnVwSHxUypZXDJfFB/z9gPk61",
+	"BGTEmY+CeDwkQKbpQEAlgUABkXHEhVExMiWQJDQS/WlaJ30tTh8kAd2naf5XlETygHrGMiRhusMRFxgu",
+	"O5Mu4URRFQeQpaqCpgGLQ+LNf/HpmGudSQUqluSo+YJccEXanoeRgmGA9QFzXAdvIYwCLdhWvVlvpqqc",
+	"IPgolrpMJZ3VZKIQqQRl40Qfa3Sxow5WMDtgD6GChfPpDtPHIy4IHccQEB7r3rgwHpx597Y21XrwYIjz",
+	"nyCY8EXXhjaJggyYEmAY8njICcTSMpTV+y5aH7At9c7vqfSD+uFqpa9VuNb3mNeSl//09hvbXBuCJU2i",
+	"iZevwL/C72OUSn/zONPOpT9CFAXUA20njQ+Ss38h3gSERPWvsRrVXupXloP/XuDIOXF+11iG5oZ9KhtX",
+	"yXAdIbgmYOYWbLCdmtr8JytmSkIITPTxwSU8pIrqCA1K0GGsuCR8KOgY1PwXQbl0icQPQBgnEdwFHHxt",
+	"IaAETOc/a2Vk2jEgN1fndS2dszTSPRLT+gGhDGWEAnxuaOoyhYJB0EcxRWGbPhZ9lx4XAmMShwQ1qYyT",
+	"MSj8CHdpfOCx/jGknuBSopjS+U9cc/EG1YT7F1y1g4B/RP/xWCAeZzIOqc8F0cNru/BQShBE53svFtJE",
+	"As1kOP9ZcZ9bT0993PCjOVm46CM6SRqPrJdoIiibgKY9E/IspcSnIxQ6tpmwpGhkwpLOuD4FEqHQPqUf",
+	"caNhj7MPMVMmOnkgwFMoUGZ7QXJz/br2MpHHax6zx9RrqrtFiE70hrdUKjSWmUYSqmNpiCzV5uW/pf23",
+	"Y8W7TMYCmIfndGMA3J2D8ggV3JzZnKFhm+C+CVM6R+A4FkYfECsezn+ZItVxz2pN26yMtSnzep6pU4io",
+	"goD+YOi+pirYD2urxlnLoJp/UnFgGfQWHVh73szaNzzE/eqrPMKu+hIoqY/MoxBswdE5HWEPmaSc7YWf",
+	"Yv/ruQmAWV4igVPqz/+i2VjJxTXnb4DdJdhBPmJc5DqDLqGDjg3SAIeIU0nCmCqQ2eCpsd2ICwjJCM0z",
+	"H5myvhaHZnYhQsos6hXzTzop8JhwEtCQKiTjgA/TCVeKWnSXHmcmZTJl+qcEFGVjaqPODYsEN6lnGGCH",
+	"KaruHjF8mmytk6ImlvloZi+Rjf6aovmvvpkqYzrH9CEH0VyCBIikTMFt6SFBqYAYUShwSbhEz5REXMr5",
+	"pykGJBWH0JCbMqlEnMgxEmhRucGqCVea6baUVCrtmhofUc/kYvB9qvmCoCe0ISiK0jkZQSDRdaLMTz86",
+	"UGwvz1ABDcqTon6CZkz4Na1SZ9D50KN2/kB4GgHqOdT+dUyZN+GkdUQmXIC0E+dzZGM1SafO5dmZEaFA",
+	"3zn5djWl7xYt+fADekqbVi7JvBLA/B3lom0IWPKFKgzlJhPLDXlqmt9pUkLKurb9siIAQoB5aGcipcIM",
+	"D40FhSA8bUfp3NBMOCMQino0AgtkyGWEjCwGzku9rwMw+FwAecN9DHhe7C83Ct3Q52aksVHYKec7iptF",
+	"Hy7icIiiLI3Ti94ftDQk9ygaz9MNkXkGhM3/x4opz/jRcfPli8PDl81m8+CfXxaM7ch1IlAKhe79PwcD",
+	"/8eDo9nvHbc8W1yvnl0IKmmC9Ovt+kZ1GDn5sadkmQydvcCmpk0QKUusHnR3g+5ZMpzZgsTEhlcYzFKf",
+	"GRa2MJ4piqRWuZOzLpsVbIcPUahYmHpahWyCvJZO2/3Ty/enl296V53ORb/7tl2KUxUqSodfFTn178EE",
+	"NN42GcRLiSoMvqBV5xvzJ6DSZBbKvPlfmE+5SwSPh5wgGcVCmQrVFOefvDjg9S1C6pLWns7nUoOgPuqI",
+	"gm3PFrYoZ2UOusynHhCJJEM9ibhvK0iWXhOiqOQB+IbXfCxKFJcQNOQ8QGAl81losiTVjbZzBgp2tJth",
+	"mhi2dgObSopU2342Upj60Odm6O1TUrFp2X21nP0qx0kqc/NfGfWMnSVRhrBCLFkacPvm+rLWbB7s4jP3",
+	"zq+2+eckWI+HKMykxF8BWvSQRCe0ABXfIlYrEGNU7dinOhls5Oi68LruAUUo28w/5cwayPbyuS43XS+c",
+	"Vanez3qfdCqJKvNaZf0V8+Evg8UqBn5SiGwN/8+47IvhsnWVnXshsgq17g2XVZoQs4sGlLN2yGO2ayoL",
+	"KXsLQVyhurdmvcqufZpsk8jOJRgSgUBlXm3HzXqzWTCfr8rm4x68mA0Gdf25VWVJs+0Y3xe0WBkftwcY",
+	"a0xi1yD7UDDgtN3bHgVUWdTOkb3Uyecm/tOCt24ZqveHAHYUSTUWmEJA/aTIlhdLT8APZmI0peOkqlPw",
+	"wRAl5n3woLVxs8NqePGQkOK6UmI7mP4YGQoI8u3zArq5Ordr96ak6aflzTEKoDIjq7wZTZSK5Emj8fHj",
+	"x7pcJJq6x8P6UDTSaXtjmRs84A3Tv8dR1mzvRT9qHVXYnYwlRj1bPlyVrxfbfXhaaOSkf9Pv9Fa6wcHx",
+	"0cFR/UVL/2vo/2qtVp6c1ka8UkGZWyHxKl3nVh6+DHDMDfmkIGMl589g8TGLeNl1s3vhxZxO94YUC5bz",
+	"WRW8zyyhnZnVOuCE2uoeQf1luXzjgV4FSzYDZotqQM16Ft5GAdclt7/PYtqSomvzZJtqaMbI8rLsXpx2",
+	"Ls66l+//eNM5a78/67y/ancv33fed/7UO7/sty83A7wVxT1D3FYFvpxp7QuFV6SZ7fF3pYv9Vgt8V51+",
+	"96xzcdptn++vzlcdTvZa57vKBNqOlPbT3yjar4L2+6/kZTZNfBkglhnwScGwCr6fQdiXA2HV233uBb4y",
+	"mtwb9MpZyz6XTo1gCnq4fHXVedt9a/LBF1wmbXtxGAdpGRXTbY92M5bHWbq53hQOmQ8aAY4oS8J/snmp",
+	"vvv+k92WJjOa2RduKcXk7VFLljocjfRcvIeC8s1xvWgjy1pIRrpaLWabLDXKdM3e84za3JxJFYoxAjy0",
+	"xFyhj2FUDaIXFSsPROKlEQgwW5jlGBSuqFq9yB7RaZarVounPYEhjcNlVXJtwTpZs6fDeLEZrLJufdDc",
+	"X+G6IuA8Vgm49/WrPSLEqqg3e2BIqDlI88uVCSOv6S1sn2VsaLhHkkgbPgC8XAsLC3TuDAN7JT53sjSm",
+	"BHjKgNlt6q7arcdxkCaHyoS0ZbU1Gg+DRqa3reqrfilKbqnVQnj9+yvVrlJVlXmsPUSwg3n4ScbcejuI",
+	"SbEz1wko+870YI55pQEhovVz88Bd/l6jYcSFsodjtXicMVWTeKjNpRHE9Lu7aSPk3nc1qsdA0aDJMaQG",
+	"RNRILkRLZHGoN/r3BxypoCQjm5TThIp1ulhz9uGBNbJq4fRZL1V6WRxk20ELqNvk8+SDpXcf7ZE2lBF6",
+	"808j6uXOvxZiyfFxFrN8Oxh8HAz+fTCQ7/7JqYyeqxC//maRfIDjZBM8F2QSh8lETKoyXcW4dvRyF2KE",
+	"PZxxBgqvaRVC0EZL0GxTT4CdjAMFrv6kz1+ipYWOqJfMQq5en9YODw+/cvURihAUJzfXp/n43Gq2DmrN",
+	"41rr4Lr58uSwedJs/oeTOU3ug8KaoiEWmSty4OYMW7eIFQ3qC3YexMLTbi3Q1J5cFtO1XfH8DMXtYkSl",
+	"yZhdRjakLQysrNwqDwzhNi0iHW4oKT1SLEn8fF34qDz39cABvVx9fw7lVbqoOLH2wJooVhOe9ZDVw3V5",
+	"nlQoFsx/HQY6oUEw5QUIvcikyHRJ4Fun1+n3L9vvL9rXN1ftc8dNf/jDzVX3rHvadt6VgtOGKPLZG1R8",
+	"HFG2YjVx486UyunClnMliNXT2JCSkXDZwnQPlI14OnMFW1lJbpH4WpcgGLD5T1BeQiCvBEgakP/7r/8m",
+	"nSxq+KtZj4lFUFDGmE+1BgyRDad8sLPXNTd8CFRcMLDnBA2IWFThMkdw+QjNgX2pVzvAnCikKqlQpeWy",
+	"anpr5DVIJAf1ARuw35FLQZEtzolaLwemUA5YjVymV3yYsmuU+JokuDh+PsaQQKyQqRQy1ZOGC1JNWxiL",
+	"OFqsz9sVG1zWmOwJywj0FT6WB4kEzAlRvVYQFLdSmEFO7aUqyS0uqdy4zFyAkUEo0q6Mx4ujn6kZmktI",
+	"Auohk5hRfjsCb4KkVW9WahPM4zoX40bSVjbOu6edi36nptss0ZXRbFJTkSRdCCa1av04rqPL3NYmDswt",
+	"ODPX4REyHUhPnEPzk0FXExNBjCfXaNqN3fVWUda8HKr5zyGBsjBXHi7THmpOG+suur72CFS52bnj5q6j",
+	"+rY61y1faehbXGbuFq/ZG362eDWyNcKt3jOXS83eFa6EaTWbq7L04r3GunsUZq5ztE0fmbtnTJOjzU0W",
+	"l0+YBsebG5RuIzENX2w1UubaD92q1drcquqgt2n71ea2xSP1M9c53kaMVdfG2EJeesPN+vbpVTjmvHUc",
+	"hiDu7u8fCsbSYIuigzvvdP+ZHaDaiWqLGdc9fHTNoYNKT62o2jxFf115dcez1/7WvXaDx2zy3QkP8aEy",
+	"a2EzXslZczPyp+imFZfRPDvoE0mrBefY5JcBHWEtskWTe3plbhOQ5EP9dWq/VrpnpkzzFJ2zdLPSs2v+",
+	"1l1zg4es9VE9jCHV+kfFbUO+vcPUtDb5uDTJ1lXIcv1KT4SXKbmRbqGryWT0xvTAOMbqIb/hIQ/4GFaO",
+	"OrnvsO8WUlkUEcrSmb2b/f8An7u1g9FZAAA=",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
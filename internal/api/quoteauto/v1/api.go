@@ -6,6 +6,9 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strconv"
+	"time"
 
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/go-oidc/pkg/provider"
@@ -13,6 +16,7 @@ import (
 	"github.com/luikyv/mock-insurer/internal/api/middleware"
 	"github.com/luikyv/mock-insurer/internal/auto"
 	"github.com/luikyv/mock-insurer/internal/customer"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
 	"github.com/luikyv/mock-insurer/internal/errorutil"
 	"github.com/luikyv/mock-insurer/internal/idempotency"
 	"github.com/luikyv/mock-insurer/internal/insurer"
@@ -23,6 +27,11 @@ import (
 
 var _ StrictServerInterface = Server{}
 
+const (
+	headerQuoteExpirationDateTime = "X-Quote-Expiration-Date-Time"
+	headerQuoteRemainingValidity  = "X-Quote-Remaining-Validity"
+)
+
 type Server struct {
 	baseURL            string
 	service            quoteauto.Service
@@ -90,7 +99,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("PATCH /request/{consentId}", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/quote-auto/v1", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/quote-auto/v1", mux, handler), swaggerVersion
 }
 
 func (s Server) PostQuoteAutoLead(ctx context.Context, req PostQuoteAutoLeadRequestObject) (PostQuoteAutoLeadResponseObject, error) {
@@ -3343,10 +3352,42 @@ func (s Server) GetQuoteAuto(ctx context.Context, request GetQuoteAutoRequestObj
 		Meta:  *api.NewMeta(),
 		Links: *api.NewLinks(s.baseURL + "/request/" + q.ConsentID + "/quote-status"),
 	}
-	return GetQuoteAuto200JSONResponse{N200QuoteStatusAutoJSONResponse(resp)}, nil
+	return quoteStatusResponse{
+		GetQuoteAuto200JSONResponse: GetQuoteAuto200JSONResponse{N200QuoteStatusAutoJSONResponse(resp)},
+		quote:                       q,
+	}, nil
+}
+
+// quoteStatusResponse informs the remaining validity of quotes in progress
+// in the response headers.
+type quoteStatusResponse struct {
+	GetQuoteAuto200JSONResponse
+	quote *quoteauto.Quote
+}
+
+func (r quoteStatusResponse) VisitGetQuoteAutoResponse(w http.ResponseWriter) error {
+	if slices.Contains([]quote.Status{quote.StatusReceived, quote.StatusEvaluated, quote.StatusAccepted}, r.quote.Status) {
+		expiresAt := r.quote.Data.ExpirationDateTime
+		remaining := max(int(time.Until(expiresAt.Time).Seconds()), 0)
+		w.Header().Set(headerQuoteExpirationDateTime, expiresAt.String())
+		w.Header().Set(headerQuoteRemainingValidity, strconv.Itoa(remaining))
+	}
+	return r.GetQuoteAuto200JSONResponse.VisitGetQuoteAutoResponse(w)
 }
 
 func writeResponseError(w http.ResponseWriter, r *http.Request, err error) {
+	// The quote specification doesn't define a specific code for expired
+	// quotes, so the generic code for unprocessable quote requests is used.
+	if errors.Is(err, quote.ErrExpired) {
+		api.WriteError(w, r, api.NewError("NAO_INFORMADO", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
+	if errors.Is(err, dynamicfield.ErrInvalidValue) {
+		api.WriteError(w, r, api.NewError("NAO_INFORMADO", http.StatusUnprocessableEntity, err.Error()))
+		return
+	}
+
 	if errors.As(err, &errorutil.Error{}) {
 		api.WriteError(w, r, api.NewError("INVALID_REQUEST", http.StatusUnprocessableEntity, err.Error()))
 		return
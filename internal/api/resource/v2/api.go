@@ -61,7 +61,7 @@ func (s Server) Handler() (http.Handler, string) {
 	mux.Handle("GET /resources", handler)
 
 	handler = middleware.FAPIID()(mux)
-	return http.StripPrefix("/open-insurance/resources/v2", handler), swaggerVersion
+	return middleware.StripPrefix("/open-insurance/resources/v2", mux, handler), swaggerVersion
 }
 
 func (s Server) ResourcesGetResources(ctx context.Context, req ResourcesGetResourcesRequestObject) (ResourcesGetResourcesResponseObject, error) {
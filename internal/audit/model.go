@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+// Call is a resource API call made with a valid access token.
+type Call struct {
+	ID uuid.UUID `gorm:"primaryKey"`
+	// ConsentID is empty for calls made with client credentials tokens.
+	ConsentID string
+	ClientID  string
+	OrgID     string
+	Method    string
+	// Endpoint is the path requested with its path parameters replaced by
+	// {id}, while Path is the path as requested.
+	Endpoint      string
+	Path          string
+	StatusCode    int
+	InteractionID string
+	// ErrorCode is the code of the first error returned, if any.
+	ErrorCode string
+	// Latency is the time taken to respond in milliseconds.
+	Latency   int64
+	CreatedAt timeutil.DateTime
+}
+
+func (Call) TableName() string {
+	return "audit_calls"
+}
+
+func (c *Call) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsAuthenticated reports whether the call was made with a valid access
+// token, i.e. whether the client was identified.
+func (c Call) IsAuthenticated() bool {
+	return c.ClientID != ""
+}
+
+// ErrorCode returns the code of the first error in an Open Insurance error
+// response body or an empty string if the body isn't an error response.
+func ErrorCode(body []byte) string {
+	var resp struct {
+		Errors []struct {
+			Code string `json:"code"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil || len(resp.Errors) == 0 {
+		return ""
+	}
+	return resp.Errors[0].Code
+}
+
+type Query struct {
+	ConsentID string
+	ClientID  string
+}
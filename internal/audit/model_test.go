@@ -0,0 +1,33 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/luikyv/mock-insurer/internal/audit"
+)
+
+func TestErrorCode(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{name: "error response", body: `{"errors":[{"code":"NAO_INFORMADO","title":"t","detail":"d"},{"code":"OTHER"}]}`, want: "NAO_INFORMADO"},
+		{name: "empty errors", body: `{"errors":[]}`, want: ""},
+		{name: "success response", body: `{"data":{}}`, want: ""},
+		{name: "not json", body: `internal error`, want: ""},
+		{name: "empty body", body: ``, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			got := audit.ErrorCode([]byte(tt.body))
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	storage Storage
+}
+
+func NewService(db *gorm.DB) Service {
+	return Service{storage: storage{db: db}}
+}
+
+func (s Service) Record(ctx context.Context, c *Call) error {
+	c.CreatedAt = timeutil.DateTimeNow()
+	return s.storage.create(ctx, c)
+}
+
+// Calls returns the most recent calls matching the query. An empty orgID
+// searches all organizations, so the query must then identify the consent or
+// the client.
+func (s Service) Calls(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Call], error) {
+	if orgID == "" && query.ConsentID == "" && query.ClientID == "" {
+		return page.Page[*Call]{}, errorutil.New("either the consent id or the client id is required")
+	}
+	return s.storage.calls(ctx, query, orgID, pag)
+}
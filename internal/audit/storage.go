@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luikyv/mock-insurer/internal/page"
+	"gorm.io/gorm"
+)
+
+type Storage interface {
+	create(context.Context, *Call) error
+	calls(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Call], error)
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func (s storage) create(ctx context.Context, c *Call) error {
+	if err := s.db.WithContext(ctx).Create(c).Error; err != nil {
+		return fmt.Errorf("could not create audit call: %w", err)
+	}
+	return nil
+}
+
+func (s storage) calls(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Call], error) {
+	q := s.db.WithContext(ctx).Model(&Call{}).Order("created_at DESC")
+	if orgID != "" {
+		q = q.Where("org_id = ?", orgID)
+	}
+	if query.ConsentID != "" {
+		q = q.Where("consent_id = ?", query.ConsentID)
+	}
+	if query.ClientID != "" {
+		q = q.Where("client_id = ?", query.ClientID)
+	}
+
+	calls, err := page.Paginate[*Call](q, pag)
+	if err != nil {
+		return page.Page[*Call]{}, fmt.Errorf("could not fetch audit calls: %w", err)
+	}
+	return calls, nil
+}
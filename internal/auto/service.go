@@ -19,6 +19,12 @@ func NewService(db *gorm.DB) Service {
 	return Service{storage: storage{db: db}}
 }
 
+func (s Service) CreatePolicy(ctx context.Context, policy *Policy) error {
+	policy.CreatedAt = timeutil.DateTimeNow()
+	policy.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.createPolicy(ctx, policy)
+}
+
 func (s Service) Policies(ctx context.Context, ownerID, orgID string, pag page.Pagination) (page.Page[*Policy], error) {
 	return s.storage.policies(ctx, ownerID, orgID, pag)
 }
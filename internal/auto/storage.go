@@ -11,6 +11,7 @@ import (
 )
 
 type Storage interface {
+	createPolicy(ctx context.Context, p *Policy) error
 	policies(ctx context.Context, ownerID, orgID string, pag page.Pagination) (page.Page[*Policy], error)
 	createConsentPolicy(ctx context.Context, c *ConsentPolicy) error
 	consentPolicy(ctx context.Context, id, consentID, orgID string) (*ConsentPolicy, error)
@@ -23,6 +24,13 @@ type storage struct {
 	db *gorm.DB
 }
 
+func (s storage) createPolicy(ctx context.Context, policy *Policy) error {
+	if err := s.db.WithContext(ctx).Create(policy).Error; err != nil {
+		return fmt.Errorf("could not create policy: %w", err)
+	}
+	return nil
+}
+
 func (s storage) policies(ctx context.Context, ownerID, orgID string, pag page.Pagination) (page.Page[*Policy], error) {
 	query := s.db.WithContext(ctx).
 		Where("org_id = ? OR cross_org = true", orgID).
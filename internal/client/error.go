@@ -0,0 +1,5 @@
+package client
+
+import "errors"
+
+var ErrNotFound = errors.New("client not found")
@@ -1,8 +1,10 @@
 package client
 
 import (
+	"github.com/google/uuid"
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
 )
 
 type Client struct {
@@ -19,3 +21,35 @@ type Client struct {
 func (Client) TableName() string {
 	return "oauth_clients"
 }
+
+// MetadataHistory records the metadata of a client after it was registered,
+// updated or deleted.
+type MetadataHistory struct {
+	ID       uuid.UUID `gorm:"primaryKey"`
+	ClientID string
+	Action   Action
+	// Meta is the client metadata after the action. Registration access tokens
+	// and secrets are not part of it.
+	Meta      goidc.ClientMeta `gorm:"serializer:json"`
+	OrgID     string
+	CreatedAt timeutil.DateTime
+}
+
+func (MetadataHistory) TableName() string {
+	return "oauth_client_history"
+}
+
+func (h *MetadataHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+type Action string
+
+const (
+	ActionRegistered Action = "REGISTERED"
+	ActionUpdated    Action = "UPDATED"
+	ActionDeleted    Action = "DELETED"
+)
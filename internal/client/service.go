@@ -2,20 +2,42 @@ package client
 
 import (
 	"context"
+	"errors"
 
+	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"gorm.io/gorm"
 )
 
 type Service struct {
-	storage Storage
+	storage        Storage
+	historyStorage MetadataHistoryStorage
 }
 
 func NewService(db *gorm.DB) Service {
-	return Service{storage: storage{db: db}}
+	return Service{
+		storage:        storage{db: db},
+		historyStorage: metadataHistoryStorage{db: db},
+	}
 }
 
+// Save registers or updates a client and records its new metadata.
 func (s Service) Save(ctx context.Context, client *Client) error {
-	return s.storage.Save(ctx, client)
+	action := ActionUpdated
+	existing, err := s.storage.Client(ctx, client.ID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		action = ActionRegistered
+		client.CreatedAt = client.UpdatedAt
+	case err != nil:
+		return err
+	default:
+		client.CreatedAt = existing.CreatedAt
+	}
+
+	if err := s.storage.Save(ctx, client); err != nil {
+		return err
+	}
+	return s.record(ctx, client, action)
 }
 
 func (s Service) Client(ctx context.Context, id string) (*Client, error) {
@@ -23,5 +45,36 @@ func (s Service) Client(ctx context.Context, id string) (*Client, error) {
 }
 
 func (s Service) Delete(ctx context.Context, id string) error {
-	return s.storage.Delete(ctx, id)
+	client, err := s.storage.Client(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.storage.Delete(ctx, id); err != nil {
+		return err
+	}
+	return s.record(ctx, client, ActionDeleted)
+}
+
+// MetadataHistory returns the metadata recorded for a client from the oldest
+// to the most recent. It is kept after the client is deleted.
+func (s Service) MetadataHistory(ctx context.Context, id, orgID string) ([]*MetadataHistory, error) {
+	history, err := s.historyStorage.history(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, ErrNotFound
+	}
+	return history, nil
+}
+
+func (s Service) record(ctx context.Context, client *Client, action Action) error {
+	return s.historyStorage.create(ctx, &MetadataHistory{
+		ClientID:  client.ID,
+		Action:    action,
+		Meta:      client.Data.ClientMeta,
+		OrgID:     client.OrgID,
+		CreatedAt: timeutil.DateTimeNow(),
+	})
 }
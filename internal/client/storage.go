@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"gorm.io/gorm"
@@ -27,6 +28,9 @@ func (s storage) Save(ctx context.Context, client *Client) error {
 func (s storage) Client(ctx context.Context, id string) (*Client, error) {
 	var client Client
 	if err := s.db.WithContext(ctx).First(&client, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return &client, nil
@@ -38,3 +42,30 @@ func (s storage) Delete(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+type MetadataHistoryStorage interface {
+	create(context.Context, *MetadataHistory) error
+	history(ctx context.Context, clientID, orgID string) ([]*MetadataHistory, error)
+}
+
+type metadataHistoryStorage struct {
+	db *gorm.DB
+}
+
+func (s metadataHistoryStorage) create(ctx context.Context, h *MetadataHistory) error {
+	if err := s.db.WithContext(ctx).Create(h).Error; err != nil {
+		return fmt.Errorf("could not create client metadata history: %w", err)
+	}
+	return nil
+}
+
+func (s metadataHistoryStorage) history(ctx context.Context, clientID, orgID string) ([]*MetadataHistory, error) {
+	var history []*MetadataHistory
+	if err := s.db.WithContext(ctx).
+		Where("client_id = ? AND org_id = ?", clientID, orgID).
+		Order("created_at ASC").
+		Find(&history).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch client metadata history: %w", err)
+	}
+	return history, nil
+}
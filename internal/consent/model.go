@@ -3,6 +3,7 @@ package consent
 import (
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/luikyv/go-oidc/pkg/goidc"
@@ -12,6 +13,9 @@ import (
 
 const (
 	URNPrefix = "urn:mockinsurer:consent:"
+	// AuthorizationTimeout is how long a consent can await authorization
+	// before being rejected as expired.
+	AuthorizationTimeout = 3600 * time.Second
 )
 
 var (
@@ -66,6 +70,48 @@ func (c Consent) HasPermissions(permissions []Permission) bool {
 	return true
 }
 
+// IsPhase3 reports whether the consent was granted for phase 3 operations such
+// as quotes and claim notifications.
+func (c Consent) IsPhase3() bool {
+	return containsAny(PermissionGroupPhase3, c.Permissions...)
+}
+
+// StatusHistory records a status transition of a consent.
+type StatusHistory struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	ConsentID uuid.UUID
+	Status    Status
+	Actor     Actor
+	Reason    *string
+	OrgID     string
+	CreatedAt timeutil.DateTime
+}
+
+func (StatusHistory) TableName() string {
+	return "consent_status_history"
+}
+
+func (h *StatusHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+// Actor is who triggered a consent status transition.
+type Actor string
+
+const (
+	ActorUser  Actor = "USER"
+	ActorASPSP Actor = "ASPSP"
+	// ActorTPP is used for the transitions requested through the consents API,
+	// e.g. when the consent is deleted.
+	ActorTPP Actor = "TPP"
+	// ActorAutomation is used for the transitions done by the mock insurer on
+	// its own, e.g. when the consent expires.
+	ActorAutomation Actor = "AUTOMATION"
+)
+
 type Status string
 
 const (
@@ -791,6 +837,35 @@ type Rejection struct {
 	ReasonAdditionalInfo *string             `json:"reasonAdditionalInfo,omitempty"`
 }
 
+// authorizationTimeoutRejection is used for consents awaiting authorization
+// for longer than AuthorizationTimeout.
+func authorizationTimeoutRejection() Rejection {
+	info := "consent awaiting authorization for too long"
+	return Rejection{
+		By:                   RejectedByUser,
+		ReasonCode:           RejectionReasonCodeConsentExpired,
+		ReasonAdditionalInfo: &info,
+	}
+}
+
+// expirationRejection is used for authorized consents past their expiration.
+func expirationRejection() Rejection {
+	info := "consent reached expiration"
+	return Rejection{
+		By:                   RejectedByASPSP,
+		ReasonCode:           RejectionReasonCodeConsentMaxDateReached,
+		ReasonAdditionalInfo: &info,
+	}
+}
+
+func (r Rejection) String() string {
+	reason := string(r.ReasonCode)
+	if r.ReasonAdditionalInfo != nil {
+		reason += ": " + *r.ReasonAdditionalInfo
+	}
+	return reason
+}
+
 type RejectedBy string
 
 const (
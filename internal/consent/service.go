@@ -4,8 +4,8 @@ import (
 	"context"
 	"log/slog"
 	"strings"
-	"time"
 
+	"github.com/google/uuid"
 	"github.com/luikyv/mock-insurer/internal/api"
 	"github.com/luikyv/mock-insurer/internal/errorutil"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
@@ -13,15 +13,24 @@ import (
 	"gorm.io/gorm"
 )
 
+// GrantRevoker revokes the grants issued for a consent.
+type GrantRevoker interface {
+	DeleteByConsentID(ctx context.Context, consentID string) error
+}
+
 type Service struct {
-	storage     Storage
-	userService user.Service
+	storage        Storage
+	historyStorage StatusHistoryStorage
+	userService    user.Service
+	grantRevoker   GrantRevoker
 }
 
-func NewService(db *gorm.DB, userService user.Service) Service {
+func NewService(db *gorm.DB, userService user.Service, grantRevoker GrantRevoker) Service {
 	return Service{
-		storage:     storage{db: db},
-		userService: userService,
+		storage:        storage{db: db},
+		historyStorage: statusHistoryStorage{db: db},
+		userService:    userService,
+		grantRevoker:   grantRevoker,
 	}
 }
 
@@ -50,7 +59,10 @@ func (s Service) Create(ctx context.Context, c *Consent) error {
 	c.StatusUpdatedAt = now
 	c.CreatedAt = now
 	c.UpdatedAt = now
-	return s.storage.create(ctx, c)
+	if err := s.storage.create(ctx, c); err != nil {
+		return err
+	}
+	return s.recordStatus(ctx, c, ActorTPP, "")
 }
 
 func (s Service) Authorize(ctx context.Context, c *Consent) error {
@@ -58,7 +70,7 @@ func (s Service) Authorize(ctx context.Context, c *Consent) error {
 		return errorutil.New("consent is not in the awaiting authorization status")
 	}
 
-	return s.updateWithStatus(ctx, c, StatusAuthorized)
+	return s.updateWithStatus(ctx, c, StatusAuthorized, ActorUser, "")
 }
 
 func (s Service) Consent(ctx context.Context, id, orgID string) (*Consent, error) {
@@ -74,24 +86,14 @@ func (s Service) Consent(ctx context.Context, id, orgID string) (*Consent, error
 
 	switch c.Status {
 	case StatusAwaitingAuthorization:
-		if timeutil.DateTimeNow().After(c.CreatedAt.Add(3600 * time.Second)) {
+		if timeutil.DateTimeNow().After(c.CreatedAt.Add(AuthorizationTimeout)) {
 			slog.DebugContext(ctx, "consent awaiting authorization for too long, moving to rejected")
-			reasonAdditionalInfo := "consent awaiting authorization for too long"
-			return c, s.reject(ctx, c, Rejection{
-				By:                   RejectedByUser,
-				ReasonCode:           RejectionReasonCodeConsentExpired,
-				ReasonAdditionalInfo: &reasonAdditionalInfo,
-			})
+			return c, s.reject(ctx, c, authorizationTimeoutRejection(), ActorAutomation)
 		}
 	case StatusAuthorized:
 		if timeutil.DateTimeNow().After(c.ExpiresAt) {
 			slog.DebugContext(ctx, "consent reached expiration, moving to rejected")
-			reasonAdditionalInfo := "consent reached expiration"
-			return c, s.reject(ctx, c, Rejection{
-				By:                   RejectedByASPSP,
-				ReasonCode:           RejectionReasonCodeConsentMaxDateReached,
-				ReasonAdditionalInfo: &reasonAdditionalInfo,
-			})
+			return c, s.reject(ctx, c, expirationRejection(), ActorAutomation)
 		}
 	}
 
@@ -104,7 +106,8 @@ func (s Service) Reject(ctx context.Context, id, orgID string, rejection Rejecti
 		return err
 	}
 
-	return s.reject(ctx, c, rejection)
+	// The rejecting party triggered the transition, and actors share their values.
+	return s.reject(ctx, c, rejection, Actor(rejection.By))
 }
 
 func (s Service) Delete(ctx context.Context, id, orgID string) error {
@@ -121,26 +124,141 @@ func (s Service) Delete(ctx context.Context, id, orgID string) error {
 		additionalInfo = "customer manually revoked consent after authorization"
 	}
 
-	return s.Reject(ctx, id, orgID, Rejection{
+	return s.reject(ctx, c, Rejection{
 		By:                   rejectedBy,
 		ReasonCode:           rejectionReason,
 		ReasonAdditionalInfo: &additionalInfo,
+	}, ActorTPP)
+}
+
+// Expire rejects in bulk the consents awaiting authorization for longer than
+// AuthorizationTimeout and the authorized consents past their expiration, the
+// same way reading them would, makes the resources shared through them
+// unavailable and revokes their grants. It returns the consents rejected.
+func (s Service) Expire(ctx context.Context) ([]*Consent, error) {
+	now := timeutil.DateTimeNow()
+	var rejected []*Consent
+	err := s.storage.transaction(ctx, func(txStorage Storage, txHistoryStorage StatusHistoryStorage) error {
+		for _, rule := range []struct {
+			status    Status
+			before    timeutil.DateTime
+			rejection Rejection
+		}{
+			{StatusAwaitingAuthorization, now.Add(-AuthorizationTimeout), authorizationTimeoutRejection()},
+			{StatusAuthorized, now, expirationRejection()},
+		} {
+			consents, err := txStorage.rejectAll(ctx, rule.status, rule.before, rule.rejection, now)
+			if err != nil {
+				return err
+			}
+
+			ids := make([]uuid.UUID, 0, len(consents))
+			history := make([]*StatusHistory, 0, len(consents))
+			reason := rule.rejection.String()
+			for _, c := range consents {
+				ids = append(ids, c.ID)
+				history = append(history, &StatusHistory{
+					ConsentID: c.ID,
+					Status:    StatusRejected,
+					Actor:     ActorAutomation,
+					Reason:    &reason,
+					OrgID:     c.OrgID,
+					CreatedAt: now,
+				})
+			}
+
+			// Only authorized consents have resources linked to them.
+			if rule.status == StatusAuthorized {
+				if err := txStorage.makeResourcesUnavailable(ctx, ids, now); err != nil {
+					return err
+				}
+			}
+			if err := txHistoryStorage.createAll(ctx, history); err != nil {
+				return err
+			}
+			rejected = append(rejected, consents...)
+		}
+		return nil
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Grants live outside the consent storage, so they are revoked once the
+	// rejections are committed. Consents that were awaiting authorization have
+	// none.
+	for _, c := range rejected {
+		if err := s.grantRevoker.DeleteByConsentID(ctx, c.ID.String()); err != nil {
+			return rejected, err
+		}
+	}
+	return rejected, nil
 }
 
-func (s Service) reject(ctx context.Context, c *Consent, rejection Rejection) error {
+// StatusHistory returns the status transitions of a consent from the oldest to
+// the most recent.
+func (s Service) StatusHistory(ctx context.Context, id, orgID string) ([]*StatusHistory, error) {
+	c, err := s.Consent(ctx, id, orgID)
+	if err != nil {
+		return nil, err
+	}
+	return s.historyStorage.history(ctx, c.ID.String(), orgID)
+}
+
+// ActiveCount returns the number of authorized consents not yet expired across
+// all organizations.
+func (s Service) ActiveCount(ctx context.Context) (int, error) {
+	return s.storage.countActive(ctx, timeutil.DateTimeNow())
+}
+
+// RejectedCount returns the number of consents rejected in [from, to) across
+// all organizations.
+func (s Service) RejectedCount(ctx context.Context, from, to timeutil.DateTime) (int, error) {
+	return s.storage.countRejected(ctx, from, to)
+}
+
+func (s Service) reject(ctx context.Context, c *Consent, rejection Rejection, actor Actor) error {
 	if c.Status == StatusRejected {
 		return ErrAlreadyRejected
 	}
 
+	wasAuthorized := c.Status == StatusAuthorized
 	c.Rejection = &rejection
-	return s.updateWithStatus(ctx, c, StatusRejected)
+	if err := s.updateWithStatus(ctx, c, StatusRejected, actor, rejection.String()); err != nil {
+		return err
+	}
+
+	if !wasAuthorized {
+		return nil
+	}
+
+	if err := s.storage.makeResourcesUnavailable(ctx, []uuid.UUID{c.ID}, c.StatusUpdatedAt); err != nil {
+		return err
+	}
+	return s.grantRevoker.DeleteByConsentID(ctx, c.ID.String())
 }
 
-func (s Service) updateWithStatus(ctx context.Context, c *Consent, status Status) error {
+func (s Service) updateWithStatus(ctx context.Context, c *Consent, status Status, actor Actor, reason string) error {
 	c.Status = status
 	c.StatusUpdatedAt = timeutil.DateTimeNow()
-	return s.update(ctx, c)
+	if err := s.update(ctx, c); err != nil {
+		return err
+	}
+	return s.recordStatus(ctx, c, actor, reason)
+}
+
+func (s Service) recordStatus(ctx context.Context, c *Consent, actor Actor, reason string) error {
+	h := &StatusHistory{
+		ConsentID: c.ID,
+		Status:    c.Status,
+		Actor:     actor,
+		OrgID:     c.OrgID,
+		CreatedAt: c.StatusUpdatedAt,
+	}
+	if reason != "" {
+		h.Reason = &reason
+	}
+	return s.historyStorage.create(ctx, h)
 }
 
 func (s Service) update(ctx context.Context, c *Consent) error {
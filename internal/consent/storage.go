@@ -5,19 +5,35 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/resource"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Storage interface {
+	transaction(ctx context.Context, fn func(Storage, StatusHistoryStorage) error) error
 	create(ctx context.Context, c *Consent) error
 	consent(ctx context.Context, id, orgID string) (*Consent, error)
 	update(ctx context.Context, c *Consent) error
+	countActive(ctx context.Context, now timeutil.DateTime) (int, error)
+	countRejected(ctx context.Context, from, to timeutil.DateTime) (int, error)
+	rejectAll(ctx context.Context, status Status, before timeutil.DateTime, rejection Rejection, now timeutil.DateTime) ([]*Consent, error)
+	makeResourcesUnavailable(ctx context.Context, ids []uuid.UUID, now timeutil.DateTime) error
 }
 
 type storage struct {
 	db *gorm.DB
 }
 
+func (s storage) transaction(ctx context.Context, fn func(Storage, StatusHistoryStorage) error) error {
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		tx = tx.WithContext(ctx)
+		return fn(storage{db: tx}, statusHistoryStorage{db: tx})
+	})
+}
+
 func (s storage) create(ctx context.Context, c *Consent) error {
 	if err := s.db.WithContext(ctx).Create(c).Error; err != nil {
 		return fmt.Errorf("could not create consent: %w", err)
@@ -48,3 +64,122 @@ func (s storage) update(ctx context.Context, c *Consent) error {
 
 	return nil
 }
+
+func (s storage) countActive(ctx context.Context, now timeutil.DateTime) (int, error) {
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&Consent{}).
+		Where("status = ? AND expires_at > ?", StatusAuthorized, now).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("could not count active consents: %w", err)
+	}
+	return int(count), nil
+}
+
+func (s storage) countRejected(ctx context.Context, from, to timeutil.DateTime) (int, error) {
+	var count int64
+	err := s.db.WithContext(ctx).
+		Model(&Consent{}).
+		Where("status = ? AND status_updated_at >= ? AND status_updated_at < ?", StatusRejected, from, to).
+		Count(&count).Error
+	if err != nil {
+		return 0, fmt.Errorf("could not count rejected consents: %w", err)
+	}
+	return int(count), nil
+}
+
+// rejectAll rejects the consents in status whose deadline is before the date
+// informed. The deadline of consents awaiting authorization is their creation
+// date, while the one of authorized consents is their expiration date.
+func (s storage) rejectAll(ctx context.Context, status Status, before timeutil.DateTime, rejection Rejection, now timeutil.DateTime) ([]*Consent, error) {
+	deadline := "created_at"
+	if status == StatusAuthorized {
+		deadline = "expires_at"
+	}
+
+	var consents []*Consent
+	err := s.db.WithContext(ctx).
+		Model(&consents).
+		Clauses(clause.Returning{}).
+		Where("status = ? AND "+deadline+" < ?", status, before).
+		Updates(&Consent{
+			Status:          StatusRejected,
+			Rejection:       &rejection,
+			StatusUpdatedAt: now,
+			UpdatedAt:       now,
+		}).Error
+	if err != nil {
+		return nil, fmt.Errorf("could not reject %s consents: %w", status, err)
+	}
+	return consents, nil
+}
+
+// resourceTables link consents to the resources shared through them. They are
+// listed by the consent_resources view.
+var resourceTables = []string{
+	"consent_insurance_auto_policies",
+	"consent_insurance_capitalization_title_plans",
+	"consent_insurance_financial_assistance_contracts",
+	"consent_insurance_acceptance_and_branches_abroad_policies",
+	"consent_insurance_financial_risk_policies",
+	"consent_insurance_housing_policies",
+	"consent_insurance_life_pension_contracts",
+	"consent_insurance_patrimonial_policies",
+}
+
+func (s storage) makeResourcesUnavailable(ctx context.Context, ids []uuid.UUID, now timeutil.DateTime) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	for _, table := range resourceTables {
+		err := s.db.WithContext(ctx).
+			Table(table).
+			Where("consent_id IN ?", ids).
+			Updates(map[string]any{"status": resource.StatusUnavailable, "updated_at": now}).Error
+		if err != nil {
+			return fmt.Errorf("could not update the resources of the consents in %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+type StatusHistoryStorage interface {
+	create(context.Context, *StatusHistory) error
+	createAll(context.Context, []*StatusHistory) error
+	history(ctx context.Context, consentID, orgID string) ([]*StatusHistory, error)
+}
+
+type statusHistoryStorage struct {
+	db *gorm.DB
+}
+
+func (s statusHistoryStorage) create(ctx context.Context, h *StatusHistory) error {
+	if err := s.db.WithContext(ctx).Create(h).Error; err != nil {
+		return fmt.Errorf("could not create consent status history: %w", err)
+	}
+	return nil
+}
+
+func (s statusHistoryStorage) createAll(ctx context.Context, history []*StatusHistory) error {
+	if len(history) == 0 {
+		return nil
+	}
+
+	if err := s.db.WithContext(ctx).Create(history).Error; err != nil {
+		return fmt.Errorf("could not create consent status history: %w", err)
+	}
+	return nil
+}
+
+func (s statusHistoryStorage) history(ctx context.Context, consentID, orgID string) ([]*StatusHistory, error) {
+	var history []*StatusHistory
+	if err := s.db.WithContext(ctx).
+		Where("consent_id = ? AND org_id = ?", consentID, orgID).
+		Order("created_at ASC").
+		Find(&history).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch consent status history: %w", err)
+	}
+	return history, nil
+}
@@ -0,0 +1,51 @@
+// Package csrf protects the forms of the mock insurer pages with the double
+// submit cookie pattern. The page embeds the token returned by Token in its
+// forms and the handler of the submission checks it with Valid.
+package csrf
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+)
+
+const (
+	cookieName = "csrf_token"
+	// FormParam is the name of the form field that must carry the token.
+	FormParam = "csrf_token"
+)
+
+// Token returns the token of the browser, which is kept in a cookie. A new one
+// is generated if the request doesn't have it yet.
+func Token(w http.ResponseWriter, r *http.Request) (string, error) {
+	if cookie, err := r.Cookie(cookieName); err == nil && cookie.Value != "" {
+		return cookie.Value, nil
+	}
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("could not generate the csrf token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     cookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+	return token, nil
+}
+
+// Valid reports whether the form submitted carries the token of the browser.
+func Valid(r *http.Request) bool {
+	cookie, err := r.Cookie(cookieName)
+	if err != nil || cookie.Value == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(r.PostFormValue(FormParam))) == 1
+}
@@ -0,0 +1,7 @@
+package discovery
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("outage not found")
+)
@@ -0,0 +1,97 @@
+package discovery
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+// Family is the API family present in the path of the Open Insurance APIs,
+// e.g. quote-auto in /open-insurance/quote-auto/v1.
+type Family string
+
+// FamilyDiscovery is never affected by outages, so clients can always check
+// the status of the other APIs.
+const FamilyDiscovery Family = "discovery"
+
+// OpenDataFamilies are the families served without mutual TLS.
+var OpenDataFamilies = []Family{FamilyDiscovery, "admin", "channels", "products-services"}
+
+var familyPattern = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// FamilyFromPath returns the API family of an Open Insurance path or an empty
+// family if the path doesn't belong to the Open Insurance APIs.
+func FamilyFromPath(path string) Family {
+	rest, ok := strings.CutPrefix(path, "/open-insurance/")
+	if !ok {
+		return ""
+	}
+	family, _, _ := strings.Cut(rest, "/")
+	return Family(family)
+}
+
+type Outage struct {
+	ID       uuid.UUID `gorm:"primaryKey"`
+	StartsAt timeutil.DateTime
+	EndsAt   timeutil.DateTime
+	// Families are the API families unavailable during the outage. When empty,
+	// all families are unavailable.
+	Families    []Family `gorm:"serializer:json"`
+	Explanation string
+	CreatedAt   timeutil.DateTime
+	UpdatedAt   timeutil.DateTime
+}
+
+func (Outage) TableName() string {
+	return "outages"
+}
+
+func (o *Outage) BeforeCreate(tx *gorm.DB) error {
+	if o.ID == uuid.Nil {
+		o.ID = uuid.New()
+	}
+	return nil
+}
+
+// IsActive reports whether the outage is ongoing at the time informed.
+func (o Outage) IsActive(now timeutil.DateTime) bool {
+	return !now.Before(o.StartsAt) && now.Before(o.EndsAt)
+}
+
+// IsPartial reports whether only some API families are affected.
+func (o Outage) IsPartial() bool {
+	return len(o.Families) != 0
+}
+
+// Affects reports whether the family is unavailable during the outage.
+func (o Outage) Affects(family Family) bool {
+	if family == FamilyDiscovery {
+		return false
+	}
+	return !o.IsPartial() || slices.Contains(o.Families, family)
+}
+
+// Duration returns the duration of the outage in the ISO 8601 format, e.g.
+// PT1H30M.
+func (o Outage) Duration() string {
+	d := o.EndsAt.Sub(o.StartsAt.Time).Round(time.Second)
+	hours, minutes, seconds := int(d.Hours()), int(d.Minutes())%60, int(d.Seconds())%60
+
+	duration := "PT"
+	if hours != 0 {
+		duration += fmt.Sprintf("%dH", hours)
+	}
+	if minutes != 0 {
+		duration += fmt.Sprintf("%dM", minutes)
+	}
+	if seconds != 0 || duration == "PT" {
+		duration += fmt.Sprintf("%dS", seconds)
+	}
+	return duration
+}
@@ -0,0 +1,67 @@
+package discovery_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/discovery"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+func TestFamilyFromPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want discovery.Family
+	}{
+		{path: "/open-insurance/quote-auto/v1/request", want: "quote-auto"},
+		{path: "/open-insurance/discovery/v1/status", want: discovery.FamilyDiscovery},
+		{path: "/open-insurance/consents", want: "consents"},
+		{path: "/authorize", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			// When.
+			got := discovery.FamilyFromPath(tt.path)
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOutage(t *testing.T) {
+	// Given.
+	start := timeutil.NewDateTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	partial := discovery.Outage{
+		StartsAt: start,
+		EndsAt:   start.Add(90 * time.Minute),
+		Families: []discovery.Family{"quote-auto"},
+	}
+	total := discovery.Outage{
+		StartsAt: start,
+		EndsAt:   start.Add(45 * time.Second),
+	}
+
+	// Then.
+	if !partial.IsActive(start) || !partial.IsActive(start.Add(time.Hour)) {
+		t.Error("outage should be active during its window")
+	}
+	if partial.IsActive(start.Add(-time.Second)) || partial.IsActive(partial.EndsAt) {
+		t.Error("outage should not be active outside its window")
+	}
+	if !partial.Affects("quote-auto") || partial.Affects("consents") {
+		t.Error("partial outage should only affect its families")
+	}
+	if !total.Affects("consents") || total.Affects(discovery.FamilyDiscovery) {
+		t.Error("total outage should affect all families except discovery")
+	}
+	if got := partial.Duration(); got != "PT1H30M" {
+		t.Errorf("got duration %s, want PT1H30M", got)
+	}
+	if got := total.Duration(); got != "PT45S" {
+		t.Errorf("got duration %s, want PT45S", got)
+	}
+}
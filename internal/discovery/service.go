@@ -0,0 +1,87 @@
+package discovery
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	storage Storage
+}
+
+func NewService(db *gorm.DB) Service {
+	return Service{storage: storage{db: db}}
+}
+
+// Schedule creates an outage. Outages can be scheduled for the past, but they
+// only affect requests while they are active.
+func (s Service) Schedule(ctx context.Context, outage *Outage) error {
+	if err := validateOutage(outage); err != nil {
+		return err
+	}
+
+	outage.CreatedAt = timeutil.DateTimeNow()
+	outage.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.create(ctx, outage)
+}
+
+// Outages returns the outages that are ongoing or scheduled for the future.
+func (s Service) Outages(ctx context.Context, pag page.Pagination) (page.Page[*Outage], error) {
+	return s.storage.outages(ctx, timeutil.DateTimeNow(), pag)
+}
+
+func (s Service) ActiveOutages(ctx context.Context) ([]*Outage, error) {
+	return s.storage.activeOutages(ctx, timeutil.DateTimeNow())
+}
+
+// ActiveOutage returns the ongoing outage affecting the family, if any.
+func (s Service) ActiveOutage(ctx context.Context, family Family) (*Outage, error) {
+	outages, err := s.ActiveOutages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, outage := range outages {
+		if outage.Affects(family) {
+			return outage, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s Service) Delete(ctx context.Context, id string) error {
+	if _, err := uuid.Parse(id); err != nil {
+		return ErrNotFound
+	}
+	return s.storage.delete(ctx, id)
+}
+
+func validateOutage(outage *Outage) error {
+	if outage.StartsAt.IsZero() || outage.EndsAt.IsZero() {
+		return errorutil.New("the outage start and end are required")
+	}
+
+	if !outage.StartsAt.Before(outage.EndsAt) {
+		return errorutil.New("the outage must end after it starts")
+	}
+
+	if outage.Explanation == "" {
+		return errorutil.New("the outage explanation is required")
+	}
+
+	for _, family := range outage.Families {
+		if !familyPattern.MatchString(string(family)) {
+			return errorutil.Format("invalid api family %s", family)
+		}
+		if family == FamilyDiscovery {
+			return errorutil.New("the discovery api cannot be affected by outages")
+		}
+	}
+
+	return nil
+}
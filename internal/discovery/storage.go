@@ -0,0 +1,59 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Storage interface {
+	create(context.Context, *Outage) error
+	outages(ctx context.Context, endsAfter timeutil.DateTime, pag page.Pagination) (page.Page[*Outage], error)
+	activeOutages(ctx context.Context, now timeutil.DateTime) ([]*Outage, error)
+	delete(ctx context.Context, id string) error
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func (s storage) create(ctx context.Context, outage *Outage) error {
+	if err := s.db.WithContext(ctx).Create(outage).Error; err != nil {
+		return fmt.Errorf("could not create outage: %w", err)
+	}
+	return nil
+}
+
+func (s storage) outages(ctx context.Context, endsAfter timeutil.DateTime, pag page.Pagination) (page.Page[*Outage], error) {
+	query := s.db.WithContext(ctx).Model(&Outage{}).Where("ends_at > ?", endsAfter).Order("starts_at ASC")
+	outages, err := page.Paginate[*Outage](query, pag)
+	if err != nil {
+		return page.Page[*Outage]{}, fmt.Errorf("could not fetch outages: %w", err)
+	}
+	return outages, nil
+}
+
+func (s storage) activeOutages(ctx context.Context, now timeutil.DateTime) ([]*Outage, error) {
+	var outages []*Outage
+	if err := s.db.WithContext(ctx).
+		Where("starts_at <= ? AND ends_at > ?", now, now).
+		Order("starts_at ASC").
+		Find(&outages).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch active outages: %w", err)
+	}
+	return outages, nil
+}
+
+func (s storage) delete(ctx context.Context, id string) error {
+	tx := s.db.WithContext(ctx).Where("id = ?", id).Delete(&Outage{})
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("could not delete outage: %w", err)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,10 @@
+package dynamicfield
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("dynamic field not found")
+	// ErrInvalidValue is returned when the custom data sent in a request
+	// doesn't comply with the dynamic fields defined.
+	ErrInvalidValue = errors.New("invalid custom data")
+)
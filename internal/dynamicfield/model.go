@@ -0,0 +1,162 @@
+package dynamicfield
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+var (
+	Scope = goidc.NewScope("dynamic-fields")
+)
+
+// Catalogue groups the dynamic fields by the family of products they apply to.
+type Catalogue string
+
+const (
+	CatalogueDamageAndPerson     Catalogue = "damage-and-person"
+	CatalogueCapitalizationTitle Catalogue = "capitalization-title"
+)
+
+// APIs are the APIs accepting custom data for each catalogue.
+var APIs = map[Catalogue][]string{
+	CatalogueDamageAndPerson: {
+		"quote-auto",
+		"quote-acceptance-and-branches-abroad",
+		"quote-financial-risk",
+		"quote-housing",
+		"quote-patrimonial-home",
+		"quote-patrimonial-condominium",
+		"quote-patrimonial-business",
+		"quote-patrimonial-diverse-risks",
+		"quote-person-life",
+		"quote-person-travel",
+		"quote-responsibility",
+		"quote-rural",
+		"quote-transport",
+	},
+	CatalogueCapitalizationTitle: {
+		"quote-capitalization-title",
+	},
+}
+
+type Field struct {
+	ID uuid.UUID `gorm:"primaryKey"`
+	// FieldID identifies the field in the customData of requests.
+	FieldID   string
+	Catalogue Catalogue
+	// API is the API where the field is sent, e.g. quote-auto.
+	API       string
+	Data      Data `gorm:"serializer:json"`
+	OrgID     string
+	CreatedAt timeutil.DateTime
+	UpdatedAt timeutil.DateTime
+}
+
+func (Field) TableName() string {
+	return "dynamic_fields"
+}
+
+func (f *Field) BeforeCreate(tx *gorm.DB) error {
+	if f.ID == uuid.Nil {
+		f.ID = uuid.New()
+	}
+	return nil
+}
+
+type Data struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Type        Type    `json:"type"`
+	IsRequired  bool    `json:"isRequired"`
+	// MaxLength and Pattern restrict values of type TEXTO.
+	MaxLength *int    `json:"maxLength,omitempty"`
+	Pattern   *string `json:"pattern,omitempty"`
+	// AllowedValues restricts the values of the field regardless of its type.
+	AllowedValues *[]string `json:"allowedValues,omitempty"`
+}
+
+type Type string
+
+const (
+	TypeText    Type = "TEXTO"
+	TypeNumber  Type = "NUMERO"
+	TypeInteger Type = "INTEIRO"
+	TypeBoolean Type = "BOOLEANO"
+	TypeDate    Type = "DATA"
+	TypeObject  Type = "OBJETO"
+	TypeList    Type = "LISTA"
+)
+
+var types = []Type{TypeText, TypeNumber, TypeInteger, TypeBoolean, TypeDate, TypeObject, TypeList}
+
+// Validate checks that value, decoded from JSON, is acceptable for the field.
+func (f Field) Validate(value any) error {
+	if value == nil {
+		return fmt.Errorf("%w: field %s has no value", ErrInvalidValue, f.FieldID)
+	}
+
+	typeErr := fmt.Errorf("%w: field %s must be of type %s", ErrInvalidValue, f.FieldID, f.Data.Type)
+	switch f.Data.Type {
+	case TypeText:
+		s, ok := value.(string)
+		if !ok {
+			return typeErr
+		}
+		if f.Data.MaxLength != nil && len([]rune(s)) > *f.Data.MaxLength {
+			return fmt.Errorf("%w: field %s exceeds %d characters", ErrInvalidValue, f.FieldID, *f.Data.MaxLength)
+		}
+		if f.Data.Pattern != nil {
+			if matched, err := regexp.MatchString(*f.Data.Pattern, s); err != nil || !matched {
+				return fmt.Errorf("%w: field %s does not match the pattern %s", ErrInvalidValue, f.FieldID, *f.Data.Pattern)
+			}
+		}
+	case TypeNumber:
+		if _, ok := value.(float64); !ok {
+			return typeErr
+		}
+	case TypeInteger:
+		n, ok := value.(float64)
+		if !ok || n != math.Trunc(n) {
+			return typeErr
+		}
+	case TypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return typeErr
+		}
+	case TypeDate:
+		s, ok := value.(string)
+		if !ok {
+			return typeErr
+		}
+		if _, err := time.Parse(time.DateOnly, s); err != nil {
+			return typeErr
+		}
+	case TypeObject:
+		if _, ok := value.(map[string]any); !ok {
+			return typeErr
+		}
+	case TypeList:
+		if _, ok := value.([]any); !ok {
+			return typeErr
+		}
+	}
+
+	if f.Data.AllowedValues != nil && !slices.Contains(*f.Data.AllowedValues, fmt.Sprint(value)) {
+		return fmt.Errorf("%w: field %s has a value not allowed", ErrInvalidValue, f.FieldID)
+	}
+
+	return nil
+}
+
+type Query struct {
+	Catalogue Catalogue
+	API       string
+}
@@ -0,0 +1,112 @@
+package dynamicfield_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+)
+
+func TestField_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    dynamicfield.Data
+		value   any
+		wantErr bool
+	}{
+		{
+			name:  "should accept text",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeText},
+			value: "value",
+		},
+		{
+			name:    "should reject number as text",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeText},
+			value:   float64(10),
+			wantErr: true,
+		},
+		{
+			name:    "should reject text longer than max length",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeText, MaxLength: pointerOf(3)},
+			value:   "value",
+			wantErr: true,
+		},
+		{
+			name:  "should accept text matching pattern",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeText, Pattern: pointerOf("^[A-Z]{3}$")},
+			value: "ABC",
+		},
+		{
+			name:    "should reject text not matching pattern",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeText, Pattern: pointerOf("^[A-Z]{3}$")},
+			value:   "abc",
+			wantErr: true,
+		},
+		{
+			name:  "should accept integer",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeInteger},
+			value: float64(10),
+		},
+		{
+			name:    "should reject decimal as integer",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeInteger},
+			value:   10.5,
+			wantErr: true,
+		},
+		{
+			name:  "should accept boolean",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeBoolean},
+			value: true,
+		},
+		{
+			name:  "should accept date",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeDate},
+			value: "2025-01-31",
+		},
+		{
+			name:    "should reject invalid date",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeDate},
+			value:   "31/01/2025",
+			wantErr: true,
+		},
+		{
+			name:  "should accept allowed value",
+			data:  dynamicfield.Data{Type: dynamicfield.TypeText, AllowedValues: &[]string{"A", "B"}},
+			value: "B",
+		},
+		{
+			name:    "should reject value not allowed",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeText, AllowedValues: &[]string{"A", "B"}},
+			value:   "C",
+			wantErr: true,
+		},
+		{
+			name:    "should reject null value",
+			data:    dynamicfield.Data{Type: dynamicfield.TypeText},
+			value:   nil,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given.
+			field := dynamicfield.Field{FieldID: "field", Data: tt.data}
+
+			// When.
+			err := field.Validate(tt.value)
+
+			// Then.
+			if tt.wantErr && !errors.Is(err, dynamicfield.ErrInvalidValue) {
+				t.Errorf("got %v, want %v", err, dynamicfield.ErrInvalidValue)
+			}
+			if !tt.wantErr && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
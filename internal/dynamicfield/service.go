@@ -0,0 +1,113 @@
+package dynamicfield
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	storage Storage
+}
+
+func NewService(db *gorm.DB) Service {
+	return Service{storage: storage{db: db}}
+}
+
+// Save creates the field or replaces the one with the same field ID in the
+// catalogue.
+func (s Service) Save(ctx context.Context, field *Field) error {
+	if err := validateField(field); err != nil {
+		return err
+	}
+
+	field.CreatedAt = timeutil.DateTimeNow()
+	field.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.save(ctx, field)
+}
+
+func (s Service) Fields(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Field], error) {
+	return s.storage.fields(ctx, query, orgID, pag)
+}
+
+func (s Service) Delete(ctx context.Context, catalogue Catalogue, fieldID, orgID string) error {
+	return s.storage.delete(ctx, catalogue, fieldID, orgID)
+}
+
+// Validate checks the custom data sent to the API against the fields the
+// organization defined for it. Organizations without fields for the API
+// accept any custom data.
+func (s Service) Validate(ctx context.Context, api string, customData *quote.CustomData, orgID string) error {
+	fields, err := s.storage.allFields(ctx, Query{API: api}, orgID)
+	if err != nil {
+		return err
+	}
+	return validate(fields, customData.Fields())
+}
+
+func validate(fields []*Field, values []quote.CustomDataField) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	for _, value := range values {
+		i := slices.IndexFunc(fields, func(f *Field) bool { return f.FieldID == value.FieldID })
+		if i == -1 {
+			return fmt.Errorf("%w: field %s is not defined", ErrInvalidValue, value.FieldID)
+		}
+		if err := fields[i].Validate(value.Value); err != nil {
+			return err
+		}
+	}
+
+	for _, field := range fields {
+		if field.Data.IsRequired && !slices.ContainsFunc(values, func(v quote.CustomDataField) bool {
+			return v.FieldID == field.FieldID
+		}) {
+			return fmt.Errorf("%w: field %s is required", ErrInvalidValue, field.FieldID)
+		}
+	}
+
+	return nil
+}
+
+func validateField(field *Field) error {
+	if field.FieldID == "" {
+		return errorutil.New("field id is required")
+	}
+
+	if field.Data.Name == "" {
+		return errorutil.New("field name is required")
+	}
+
+	apis, ok := APIs[field.Catalogue]
+	if !ok {
+		return errorutil.Format("invalid catalogue %s", field.Catalogue)
+	}
+	if !slices.Contains(apis, field.API) {
+		return errorutil.Format("api %s is not part of the catalogue %s", field.API, field.Catalogue)
+	}
+
+	if !slices.Contains(types, field.Data.Type) {
+		return errorutil.Format("invalid field type %s", field.Data.Type)
+	}
+
+	if field.Data.MaxLength != nil && *field.Data.MaxLength < 1 {
+		return errorutil.New("max length must be positive")
+	}
+
+	if field.Data.Pattern != nil {
+		if _, err := regexp.Compile(*field.Data.Pattern); err != nil {
+			return errorutil.Format("invalid pattern %s", *field.Data.Pattern)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package dynamicfield
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luikyv/mock-insurer/internal/page"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Storage interface {
+	save(context.Context, *Field) error
+	fields(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Field], error)
+	allFields(ctx context.Context, query Query, orgID string) ([]*Field, error)
+	delete(ctx context.Context, catalogue Catalogue, fieldID, orgID string) error
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func (s storage) save(ctx context.Context, field *Field) error {
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "org_id"}, {Name: "catalogue"}, {Name: "field_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"api", "data", "updated_at"}),
+		}).
+		Create(field).Error
+	if err != nil {
+		return fmt.Errorf("could not save dynamic field: %w", err)
+	}
+	return nil
+}
+
+func (s storage) fields(ctx context.Context, query Query, orgID string, pag page.Pagination) (page.Page[*Field], error) {
+	fields, err := page.Paginate[*Field](s.query(ctx, query, orgID), pag)
+	if err != nil {
+		return page.Page[*Field]{}, fmt.Errorf("could not fetch dynamic fields: %w", err)
+	}
+	return fields, nil
+}
+
+func (s storage) allFields(ctx context.Context, query Query, orgID string) ([]*Field, error) {
+	var fields []*Field
+	if err := s.query(ctx, query, orgID).Find(&fields).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch dynamic fields: %w", err)
+	}
+	return fields, nil
+}
+
+func (s storage) delete(ctx context.Context, catalogue Catalogue, fieldID, orgID string) error {
+	tx := s.db.WithContext(ctx).
+		Where("catalogue = ? AND field_id = ? AND org_id = ?", catalogue, fieldID, orgID).
+		Delete(&Field{})
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("could not delete dynamic field: %w", err)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (s storage) query(ctx context.Context, query Query, orgID string) *gorm.DB {
+	q := s.db.WithContext(ctx).Model(&Field{}).Where("org_id = ?", orgID).Order("api ASC, field_id ASC")
+	if query.Catalogue != "" {
+		q = q.Where("catalogue = ?", query.Catalogue)
+	}
+	if query.API != "" {
+		q = q.Where("api = ?", query.API)
+	}
+	return q
+}
@@ -0,0 +1,48 @@
+package insurer
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// IOFRate is the rate of the IOF (Imposto sobre Operações Financeiras)
+// applied over the net premium of damage insurance.
+var IOFRate = big.NewRat(738, 10000)
+
+// NewAmount returns monetary amount details in BRL for the given value
+// rounded to two decimal places.
+func NewAmount(value *big.Rat) AmountDetails {
+	return AmountDetails{
+		Amount:   value.FloatString(2),
+		UnitType: UnitTypeMonetary,
+		Unit: &Unit{
+			Code:        UnitCodeReal,
+			Description: CurrencyBRL,
+		},
+	}
+}
+
+// NewPercentage returns percentage amount details for the given rate, e.g.
+// 0.05 results in "5.00".
+func NewPercentage(rate *big.Rat) AmountDetails {
+	return AmountDetails{
+		Amount:   new(big.Rat).Mul(rate, big.NewRat(100, 1)).FloatString(2),
+		UnitType: UnitTypePercentage,
+	}
+}
+
+// Value parses the amount into an exact decimal representation.
+func (a AmountDetails) Value() (*big.Rat, error) {
+	value, ok := new(big.Rat).SetString(a.Amount)
+	if !ok {
+		return nil, fmt.Errorf("invalid amount %q", a.Amount)
+	}
+	return value, nil
+}
+
+// Round rounds the value to two decimal places, with halves rounded away from
+// zero.
+func Round(value *big.Rat) *big.Rat {
+	rounded, _ := new(big.Rat).SetString(value.FloatString(2))
+	return rounded
+}
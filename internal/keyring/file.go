@@ -0,0 +1,121 @@
+package keyring
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+)
+
+// Load reads a keyring file generated by the keymaker.
+func Load(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, fmt.Errorf("could not read the keyring file: %w", err)
+	}
+
+	var k Keyring
+	if err := json.Unmarshal(data, &k); err != nil {
+		return nil, fmt.Errorf("could not decode the keyring file: %w", err)
+	}
+
+	if err := k.validate(); err != nil {
+		return nil, fmt.Errorf("invalid keyring file: %w", err)
+	}
+	return &k, nil
+}
+
+// Save writes the keyring to a temporary file and renames it, so readers never
+// see a partially written keyring.
+func (k Keyring) Save(path string) error {
+	data, err := json.MarshalIndent(k, "", " ")
+	if err != nil {
+		return fmt.Errorf("could not encode the keyring: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("could not create the keyring file: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("could not write the keyring file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("could not write the keyring file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("could not replace the keyring file: %w", err)
+	}
+	return nil
+}
+
+// File serves the keys of a keyring file, reloading it whenever it changes so
+// rotations take effect without restarting the server. It is safe for
+// concurrent use.
+type File struct {
+	path string
+
+	mu      sync.Mutex
+	modTime time.Time
+	keyring *Keyring
+}
+
+func Open(path string) (*File, error) {
+	f := &File{path: path}
+	if _, err := f.load(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// JWKS is a [goidc.JWKSFunc] returning the current and retiring keys. If the
+// file can't be reloaded, the last keyring loaded is used.
+func (f *File) JWKS(_ context.Context) (goidc.JSONWebKeySet, error) {
+	k, err := f.load()
+	if err != nil {
+		return goidc.JSONWebKeySet{}, err
+	}
+	return k.JWKS()
+}
+
+func (f *File) load() (*Keyring, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	info, err := os.Stat(f.path)
+	if err != nil {
+		if f.keyring != nil {
+			slog.Warn("could not stat the keyring file, using the keys loaded before", "error", err)
+			return f.keyring, nil
+		}
+		return nil, fmt.Errorf("could not stat the keyring file: %w", err)
+	}
+
+	if f.keyring != nil && info.ModTime().Equal(f.modTime) {
+		return f.keyring, nil
+	}
+
+	k, err := Load(f.path)
+	if err != nil {
+		if f.keyring != nil {
+			slog.Warn("could not reload the keyring file, using the keys loaded before", "error", err)
+			// Don't retry until the file changes again.
+			f.modTime = info.ModTime()
+			return f.keyring, nil
+		}
+		return nil, err
+	}
+
+	f.keyring = k
+	f.modTime = info.ModTime()
+	return k, nil
+}
@@ -0,0 +1,144 @@
+package keyring
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+const keySize = 4096
+
+// Keyring holds the private keys of the authorization server.
+// The current keys are used for signing and published for encryption. Retired
+// keys are still published and accepted for decryption until they expire, so
+// clients caching the JWKS have time to pick up the new keys.
+type Keyring struct {
+	SigningKeyID    string `json:"signing_kid"`
+	EncryptionKeyID string `json:"encryption_kid"`
+	Keys            []Key  `json:"keys"`
+}
+
+type Key struct {
+	JWK goidc.JSONWebKey `json:"jwk"`
+	// ExpiresAt is set when the key is retired.
+	ExpiresAt *timeutil.DateTime `json:"expires_at,omitempty"`
+}
+
+func (k Key) isExpired(now timeutil.DateTime) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// New generates a keyring with a PS256 signing key and an RSA-OAEP encryption
+// key.
+func New() (*Keyring, error) {
+	k := &Keyring{}
+	if err := k.addCurrentKeys(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// Rotate generates new current keys. The previous current keys are kept for the
+// grace period, and keys whose grace period is over are removed.
+func (k *Keyring) Rotate(grace time.Duration) error {
+	now := timeutil.DateTimeNow()
+	k.Keys = slices.DeleteFunc(k.Keys, func(key Key) bool {
+		return key.isExpired(now)
+	})
+
+	expiresAt := now.Add(grace)
+	for i, key := range k.Keys {
+		if key.ExpiresAt == nil {
+			k.Keys[i].ExpiresAt = &expiresAt
+		}
+	}
+
+	return k.addCurrentKeys()
+}
+
+// JWKS returns the keys that are not expired with the current ones first, since
+// the provider signs with the first key matching the algorithm.
+func (k Keyring) JWKS() (goidc.JSONWebKeySet, error) {
+	now := timeutil.DateTimeNow()
+	var jwks goidc.JSONWebKeySet
+	for _, kid := range []string{k.SigningKeyID, k.EncryptionKeyID} {
+		key, ok := k.key(kid)
+		if !ok {
+			return goidc.JSONWebKeySet{}, fmt.Errorf("current key %s not found in the keyring", kid)
+		}
+		if key.isExpired(now) {
+			return goidc.JSONWebKeySet{}, fmt.Errorf("current key %s is expired", kid)
+		}
+		jwks.Keys = append(jwks.Keys, key.JWK)
+	}
+
+	for _, key := range k.Keys {
+		if key.JWK.KeyID == k.SigningKeyID || key.JWK.KeyID == k.EncryptionKeyID || key.isExpired(now) {
+			continue
+		}
+		jwks.Keys = append(jwks.Keys, key.JWK)
+	}
+	return jwks, nil
+}
+
+func (k Keyring) validate() error {
+	if k.SigningKeyID == "" || k.EncryptionKeyID == "" {
+		return errors.New("the keyring must define the current signing and encryption keys")
+	}
+	for _, key := range k.Keys {
+		if !key.JWK.Valid() || key.JWK.IsPublic() {
+			return fmt.Errorf("key %s is not a valid private key", key.JWK.KeyID)
+		}
+	}
+	_, err := k.JWKS()
+	return err
+}
+
+func (k Keyring) key(kid string) (Key, bool) {
+	for _, key := range k.Keys {
+		if key.JWK.KeyID == kid {
+			return key, true
+		}
+	}
+	return Key{}, false
+}
+
+func (k *Keyring) addCurrentKeys() error {
+	sigKey, err := generateKey(string(goidc.PS256), goidc.KeyUsageSignature)
+	if err != nil {
+		return err
+	}
+
+	encKey, err := generateKey(string(goidc.RSA_OAEP), goidc.KeyUsageEncryption)
+	if err != nil {
+		return err
+	}
+
+	k.Keys = append([]Key{sigKey, encKey}, k.Keys...)
+	k.SigningKeyID = sigKey.JWK.KeyID
+	k.EncryptionKeyID = encKey.JWK.KeyID
+	return nil
+}
+
+func generateKey(alg string, use goidc.KeyUsage) (Key, error) {
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return Key{}, fmt.Errorf("could not generate the %s key: %w", alg, err)
+	}
+
+	return Key{
+		JWK: goidc.JSONWebKey{
+			Key:       key,
+			KeyID:     uuid.NewString(),
+			Algorithm: alg,
+			Use:       string(use),
+		},
+	}, nil
+}
@@ -0,0 +1,84 @@
+package keyring_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/keyring"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+func TestJWKS(t *testing.T) {
+	// Given.
+	retiring := timeutil.DateTimeNow().Add(time.Hour)
+	expired := timeutil.DateTimeNow().Add(-time.Hour)
+	k := keyring.Keyring{
+		SigningKeyID:    "sig_current",
+		EncryptionKeyID: "enc_current",
+		Keys: []keyring.Key{
+			{JWK: jwk(t, "sig_retiring", string(goidc.PS256)), ExpiresAt: &retiring},
+			{JWK: jwk(t, "sig_expired", string(goidc.PS256)), ExpiresAt: &expired},
+			{JWK: jwk(t, "enc_current", string(goidc.RSA_OAEP))},
+			{JWK: jwk(t, "sig_current", string(goidc.PS256))},
+		},
+	}
+
+	// When.
+	jwks, err := k.JWKS()
+
+	// Then.
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var kids []string
+	for _, key := range jwks.Keys {
+		kids = append(kids, key.KeyID)
+	}
+	want := []string{"sig_current", "enc_current", "sig_retiring"}
+	if len(kids) != len(want) {
+		t.Fatalf("got keys %v, want %v", kids, want)
+	}
+	for i := range want {
+		if kids[i] != want[i] {
+			t.Errorf("got keys %v, want %v", kids, want)
+		}
+	}
+
+	if key, _ := jwks.KeyByAlg(string(goidc.PS256)); key.KeyID != "sig_current" {
+		t.Errorf("got signing key %s, want sig_current", key.KeyID)
+	}
+}
+
+func TestJWKS_CurrentKeyExpired(t *testing.T) {
+	// Given.
+	expired := timeutil.DateTimeNow().Add(-time.Hour)
+	k := keyring.Keyring{
+		SigningKeyID:    "sig_current",
+		EncryptionKeyID: "enc_current",
+		Keys: []keyring.Key{
+			{JWK: jwk(t, "sig_current", string(goidc.PS256)), ExpiresAt: &expired},
+			{JWK: jwk(t, "enc_current", string(goidc.RSA_OAEP))},
+		},
+	}
+
+	// When.
+	_, err := k.JWKS()
+
+	// Then.
+	if err == nil {
+		t.Error("got no error, want the expired current key to be rejected")
+	}
+}
+
+func jwk(t *testing.T, kid string, alg string) goidc.JSONWebKey {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("could not generate key: %v", err)
+	}
+	return goidc.JSONWebKey{Key: key, KeyID: kid, Algorithm: alg}
+}
@@ -0,0 +1,119 @@
+package metric
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+// PreviousDays is the number of days before the current one reported for the
+// period ALL.
+const PreviousDays = 7
+
+type Period string
+
+const (
+	PeriodCurrent Period = "CURRENT"
+	PeriodAll     Period = "ALL"
+)
+
+// Request is a request served by the Open Insurance APIs.
+type Request struct {
+	ID uuid.UUID `gorm:"primaryKey"`
+	// Endpoint is the path requested with its path parameters replaced by
+	// {id}, see [Endpoint].
+	Endpoint   string
+	Method     string
+	StatusCode int
+	// ResponseTime is the time taken to respond in milliseconds.
+	ResponseTime int64
+	CreatedAt    timeutil.DateTime
+}
+
+func (Request) TableName() string {
+	return "request_metrics"
+}
+
+func (r *Request) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == uuid.Nil {
+		r.ID = uuid.New()
+	}
+	return nil
+}
+
+var versionPattern = regexp.MustCompile(`^v\d+$`)
+
+// Endpoint groups the paths of the same endpoint by replacing the path
+// parameters with {id}. Resource names in Open Insurance paths never contain
+// digits, so any other segment with a digit or a colon, e.g. a URN, is taken
+// as a parameter.
+func Endpoint(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if versionPattern.MatchString(segment) {
+			continue
+		}
+		if strings.ContainsAny(segment, "0123456789:") {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+type Report struct {
+	ActiveConsents int
+	// Days are the metrics of each day in the period starting from the
+	// current one.
+	Days []Day
+	// Endpoints are the metrics of each endpoint for the whole period.
+	Endpoints []EndpointMetrics
+}
+
+// UptimeRate is the rate of requests in the period answered without a server
+// error.
+func (r Report) UptimeRate() float64 {
+	invocations, serverErrors := 0, 0
+	for _, d := range r.Days {
+		invocations += d.Invocations
+		serverErrors += d.ServerErrors
+	}
+	if invocations == 0 {
+		return 1
+	}
+	return 1 - float64(serverErrors)/float64(invocations)
+}
+
+type Day struct {
+	Date         timeutil.BrazilDate
+	Invocations  int
+	Errors       int
+	ServerErrors int
+	// AverageResponseTime is in milliseconds.
+	AverageResponseTime int64
+	// Rejections is the number of consents rejected in the day.
+	Rejections int
+}
+
+type EndpointMetrics struct {
+	Endpoint     string
+	Method       string
+	Invocations  int
+	Errors       int
+	ServerErrors int
+	// The response times are in milliseconds.
+	AverageResponseTime int64 `gorm:"column:average"`
+	P50                 int64
+	P90                 int64
+	P99                 int64
+}
+
+// ErrorRate is the rate of requests answered with a 4xx or 5xx status.
+func (e EndpointMetrics) ErrorRate() float64 {
+	if e.Invocations == 0 {
+		return 0
+	}
+	return float64(e.Errors) / float64(e.Invocations)
+}
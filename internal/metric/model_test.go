@@ -0,0 +1,79 @@
+package metric_test
+
+import (
+	"testing"
+
+	"github.com/luikyv/mock-insurer/internal/metric"
+)
+
+func TestEndpoint(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{
+			name: "should keep paths without parameters",
+			path: "/open-insurance/consents/v2/consents",
+			want: "/open-insurance/consents/v2/consents",
+		},
+		{
+			name: "should replace urn",
+			path: "/open-insurance/consents/v2/consents/urn:mockinsurer:consent:7e3f2a10-5a1c-4e5b-9d2a-0c6f3b9a1d11",
+			want: "/open-insurance/consents/v2/consents/{id}",
+		},
+		{
+			name: "should replace uuid in the middle of the path",
+			path: "/open-insurance/insurance-auto/v1/insurance-auto/bd2e3d2c-5a10-4b1f-a7a4-2f7c1c0a7c55/policy-info",
+			want: "/open-insurance/insurance-auto/v1/insurance-auto/{id}/policy-info",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			got := metric.Endpoint(tt.path)
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReport_UptimeRate(t *testing.T) {
+	tests := []struct {
+		name string
+		days []metric.Day
+		want float64
+	}{
+		{
+			name: "should be full without requests",
+			want: 1,
+		},
+		{
+			name: "should discount server errors",
+			days: []metric.Day{
+				{Invocations: 6, Errors: 3, ServerErrors: 1},
+				{Invocations: 4, Errors: 1, ServerErrors: 1},
+			},
+			want: 0.8,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given.
+			report := metric.Report{Days: tt.days}
+
+			// When.
+			got := report.UptimeRate()
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
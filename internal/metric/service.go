@@ -0,0 +1,80 @@
+package metric
+
+import (
+	"context"
+
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	storage        Storage
+	consentService consent.Service
+}
+
+func NewService(db *gorm.DB, consentService consent.Service) Service {
+	return Service{
+		storage:        storage{db: db},
+		consentService: consentService,
+	}
+}
+
+func (s Service) Record(ctx context.Context, r *Request) error {
+	r.CreatedAt = timeutil.DateTimeNow()
+	return s.storage.create(ctx, r)
+}
+
+// Report aggregates the metrics by day for the period. Days follow the Brazil
+// time zone.
+func (s Service) Report(ctx context.Context, period Period) (Report, error) {
+	if period != PeriodCurrent && period != PeriodAll {
+		return Report{}, errorutil.Format("invalid period %s", period)
+	}
+
+	days := 1
+	if period == PeriodAll {
+		days += PreviousDays
+	}
+
+	today := timeutil.BrazilDateNow()
+	report := Report{}
+	for i := range days {
+		date := today.AddDate(0, 0, -i)
+		from, to := date.StartOfDay().DateTime(), date.AddDate(0, 0, 1).StartOfDay().DateTime()
+
+		day, err := s.storage.day(ctx, from, to)
+		if err != nil {
+			return Report{}, err
+		}
+		day.Date = date
+
+		day.Rejections, err = s.consentService.RejectedCount(ctx, from, to)
+		if err != nil {
+			return Report{}, err
+		}
+		report.Days = append(report.Days, day)
+	}
+
+	from := today.AddDate(0, 0, 1-days).StartOfDay().DateTime()
+	to := today.AddDate(0, 0, 1).StartOfDay().DateTime()
+	endpoints, err := s.storage.endpoints(ctx, from, to)
+	if err != nil {
+		return Report{}, err
+	}
+	report.Endpoints = endpoints
+
+	report.ActiveConsents, err = s.consentService.ActiveCount(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return report, nil
+}
+
+// Prune deletes the requests older than the period ALL covers.
+func (s Service) Prune(ctx context.Context) error {
+	before := timeutil.BrazilDateNow().AddDate(0, 0, -PreviousDays).StartOfDay().DateTime()
+	return s.storage.deleteBefore(ctx, before)
+}
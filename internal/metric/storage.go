@@ -0,0 +1,72 @@
+package metric
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Storage interface {
+	create(context.Context, *Request) error
+	day(ctx context.Context, from, to timeutil.DateTime) (Day, error)
+	endpoints(ctx context.Context, from, to timeutil.DateTime) ([]EndpointMetrics, error)
+	deleteBefore(ctx context.Context, before timeutil.DateTime) error
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func (s storage) create(ctx context.Context, r *Request) error {
+	if err := s.db.WithContext(ctx).Create(r).Error; err != nil {
+		return fmt.Errorf("could not create request metric: %w", err)
+	}
+	return nil
+}
+
+func (s storage) day(ctx context.Context, from, to timeutil.DateTime) (Day, error) {
+	var day Day
+	err := s.db.WithContext(ctx).
+		Model(&Request{}).
+		Select(`count(*) AS invocations,
+			count(*) FILTER (WHERE status_code >= 400) AS errors,
+			count(*) FILTER (WHERE status_code >= 500) AS server_errors,
+			coalesce(round(avg(response_time)), 0)::bigint AS average_response_time`).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Scan(&day).Error
+	if err != nil {
+		return Day{}, fmt.Errorf("could not aggregate request metrics: %w", err)
+	}
+	return day, nil
+}
+
+func (s storage) endpoints(ctx context.Context, from, to timeutil.DateTime) ([]EndpointMetrics, error) {
+	var endpoints []EndpointMetrics
+	err := s.db.WithContext(ctx).
+		Model(&Request{}).
+		Select(`endpoint, method,
+			count(*) AS invocations,
+			count(*) FILTER (WHERE status_code >= 400) AS errors,
+			count(*) FILTER (WHERE status_code >= 500) AS server_errors,
+			round(avg(response_time))::bigint AS average,
+			percentile_disc(0.5) WITHIN GROUP (ORDER BY response_time) AS p50,
+			percentile_disc(0.9) WITHIN GROUP (ORDER BY response_time) AS p90,
+			percentile_disc(0.99) WITHIN GROUP (ORDER BY response_time) AS p99`).
+		Where("created_at >= ? AND created_at < ?", from, to).
+		Group("endpoint, method").
+		Order("endpoint ASC, method ASC").
+		Scan(&endpoints).Error
+	if err != nil {
+		return nil, fmt.Errorf("could not aggregate request metrics by endpoint: %w", err)
+	}
+	return endpoints, nil
+}
+
+func (s storage) deleteBefore(ctx context.Context, before timeutil.DateTime) error {
+	if err := s.db.WithContext(ctx).Where("created_at < ?", before).Delete(&Request{}).Error; err != nil {
+		return fmt.Errorf("could not delete request metrics: %w", err)
+	}
+	return nil
+}
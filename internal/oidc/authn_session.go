@@ -2,7 +2,7 @@ package oidc
 
 import (
 	"context"
-	"errors"
+	"fmt"
 
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
@@ -23,6 +23,7 @@ func (m AuthnSessionManager) Save(ctx context.Context, as *goidc.AuthnSession) e
 		CallbackID:      as.CallbackID,
 		AuthCode:        as.AuthCode,
 		PushedAuthReqID: as.PushedAuthReqID,
+		CIBAAuthID:      as.CIBAAuthID,
 		ExpiresAt:       timeutil.ParseTimestamp(as.ExpiresAtTimestamp),
 		Data:            *as,
 		UpdatedAt:       timeutil.DateTimeNow(),
@@ -45,7 +46,26 @@ func (m AuthnSessionManager) SessionByPushedAuthReqID(ctx context.Context, id st
 }
 
 func (m AuthnSessionManager) SessionByCIBAAuthID(ctx context.Context, id string) (*goidc.AuthnSession, error) {
-	return nil, errors.ErrUnsupported
+	return m.session(ctx, m.db.Where("ciba_auth_id = ?", id))
+}
+
+// PendingCIBASessions returns the CIBA requests still waiting for the user to
+// answer them.
+func (m AuthnSessionManager) PendingCIBASessions(ctx context.Context, userID, orgID string) ([]*goidc.AuthnSession, error) {
+	var sessions []*Session
+	if err := m.db.WithContext(ctx).
+		Where("ciba_auth_id <> '' AND expires_at > ? AND org_id = ?", timeutil.DateTimeNow(), orgID).
+		Where("data->'store'->>? = ? AND data->'store'->>? = ?", sessionParamUserID, userID, sessionParamCIBAStatus, cibaStatusPending).
+		Order("created_at DESC").
+		Find(&sessions).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch the pending ciba sessions: %w", err)
+	}
+
+	authnSessions := make([]*goidc.AuthnSession, len(sessions))
+	for i, s := range sessions {
+		authnSessions[i] = &s.Data
+	}
+	return authnSessions, nil
 }
 
 func (m AuthnSessionManager) Delete(ctx context.Context, id string) error {
@@ -67,8 +87,10 @@ type Session struct {
 	CallbackID      string
 	AuthCode        string
 	PushedAuthReqID string
-	ExpiresAt       timeutil.DateTime
-	Data            goidc.AuthnSession `gorm:"serializer:json"`
+	// CIBAAuthID is only set for sessions created through CIBA.
+	CIBAAuthID string
+	ExpiresAt  timeutil.DateTime
+	Data       goidc.AuthnSession `gorm:"serializer:json"`
 
 	OrgID     string
 	CreatedAt timeutil.DateTime
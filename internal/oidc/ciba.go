@@ -0,0 +1,497 @@
+package oidc
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/go-oidc/pkg/provider"
+	"github.com/luikyv/mock-insurer/internal/acceptancebranchesabroad"
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/capitalizationtitle"
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/csrf"
+	"github.com/luikyv/mock-insurer/internal/financialassistance"
+	"github.com/luikyv/mock-insurer/internal/financialrisk"
+	"github.com/luikyv/mock-insurer/internal/housing"
+	"github.com/luikyv/mock-insurer/internal/lifepension"
+	"github.com/luikyv/mock-insurer/internal/patrimonial"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/luikyv/mock-insurer/internal/user"
+	"github.com/luikyv/mock-insurer/ui"
+	"github.com/unrolled/secure"
+)
+
+const (
+	sessionParamCIBAStatus = "ciba_status"
+
+	cibaStatusPending  = "pending"
+	cibaStatusApproved = "approved"
+	cibaStatusDenied   = "denied"
+
+	// appSessionCookie keeps the user signed in to the app, so only they can
+	// see and answer their CIBA requests.
+	appSessionCookie   = "app_session"
+	appSessionLifetime = 15 * time.Minute
+)
+
+var (
+	errAppSignInRequired    = errors.New("please sign in to the app to answer this request")
+	errAppSessionExpired    = errors.New("your session expired, please reload the page")
+	errAppRequestNotFound   = errors.New("request not found")
+	errAppRequestNotPending = errors.New("this request is no longer pending")
+)
+
+// InitBackAuthFunc starts a CIBA request for a consent. The user is identified
+// by the login hint, either their CPF or their username, and approves the
+// request later in the insurer app. Requests that can't accept LOA2 are
+// refused.
+func InitBackAuthFunc(op *provider.Provider, userService user.Service, consentService consent.Service) goidc.InitBackAuthFunc {
+	return func(ctx context.Context, as *goidc.AuthnSession) error {
+		consentID, ok := consent.IDFromScopes(as.Scopes)
+		if !ok {
+			return goidc.NewError(goidc.ErrorCodeInvalidScope, "a consent scope is required")
+		}
+
+		// The app doesn't ask for the one-time password, so users answering
+		// CIBA requests only reach LOA2.
+		if _, essential := loa3Requested(as); essential {
+			return goidc.NewError(errorCodeUnmetAuthenticationRequirements, "loa3 is not supported for ciba requests")
+		}
+
+		client, err := op.Client(ctx, as.ClientID)
+		if err != nil {
+			return fmt.Errorf("could not get client for starting ciba: %w", err)
+		}
+		orgID := client.CustomAttribute(OrgIDKey).(string)
+
+		if as.LoginHint == "" {
+			return goidc.NewError(goidc.ErrorCodeInvalidRequest, "login_hint is required")
+		}
+		u, err := userService.User(ctx, user.Query{CPF: as.LoginHint}, orgID)
+		if errors.Is(err, user.ErrNotFound) {
+			u, err = userService.User(ctx, user.Query{Username: as.LoginHint}, orgID)
+		}
+		if err != nil {
+			if errors.Is(err, user.ErrNotFound) {
+				return goidc.NewError(goidc.ErrorCodeUnknownUserID, "the user informed in the login hint was not found")
+			}
+			return fmt.Errorf("could not fetch the user for starting ciba: %w", err)
+		}
+
+		c, err := consentService.Consent(ctx, consentID, orgID)
+		if err != nil {
+			if errors.Is(err, consent.ErrNotFound) {
+				return goidc.NewError(goidc.ErrorCodeInvalidScope, "consent not found")
+			}
+			return fmt.Errorf("could not fetch the consent for starting ciba: %w", err)
+		}
+
+		if c.Status != consent.StatusAwaitingAuthorization {
+			return goidc.NewError(goidc.ErrorCodeInvalidRequest, "consent is not awaiting authorization")
+		}
+
+		if c.UserIdentification != u.CPF {
+			return goidc.NewError(goidc.ErrorCodeInvalidRequest, "consent was not created for the user informed in the login hint")
+		}
+
+		if c.BusinessIdentification != nil {
+			business, err := userService.Business(ctx, u.ID.String(), *c.BusinessIdentification, orgID)
+			if err != nil {
+				slog.InfoContext(ctx, "could not fetch the business", "error", err)
+				return goidc.NewError(goidc.ErrorCodeAccessDenied, "user has no access to the business")
+			}
+			as.StoreParameter(sessionParamBusinessID, business.ID.String())
+		}
+
+		as.StoreParameter(OrgIDKey, orgID)
+		as.StoreParameter(sessionParamConsentID, consentID)
+		as.StoreParameter(sessionParamUserID, u.ID.String())
+		as.StoreParameter(sessionParamCPF, u.CPF)
+		as.StoreParameter(sessionParamCIBAStatus, cibaStatusPending)
+		return nil
+	}
+}
+
+// ValidateBackAuthFunc tells clients polling the token endpoint whether the user
+// has already answered the CIBA request.
+func ValidateBackAuthFunc() goidc.ValidateBackAuthFunc {
+	return func(_ context.Context, as *goidc.AuthnSession) error {
+		switch as.StoredParameter(sessionParamCIBAStatus) {
+		case cibaStatusApproved:
+			return nil
+		case cibaStatusDenied:
+			return goidc.NewError(goidc.ErrorCodeAccessDenied, "the user denied the request")
+		default:
+			return goidc.NewError(goidc.ErrorCodeAuthPending, "the user has not answered the request yet")
+		}
+	}
+}
+
+// App is a mock of the insurer's mobile app, where users answer the CIBA
+// requests waiting for them.
+type App struct {
+	host           string
+	op             *provider.Provider
+	sessions       AuthnSessionManager
+	userService    user.Service
+	consentService consent.Service
+	granter        consentGranter
+	tmpl           *template.Template
+	// sessionKey signs the app session cookies. It is generated on start, so
+	// users sign in again after a restart.
+	sessionKey []byte
+}
+
+func NewApp(
+	host string,
+	op *provider.Provider,
+	sessions AuthnSessionManager,
+	userService user.Service,
+	consentService consent.Service,
+	autoService auto.Service,
+	capitalizationTitleService capitalizationtitle.Service,
+	financialAssistanceService financialassistance.Service,
+	acceptanceAndBranchesAbroadService acceptancebranchesabroad.Service,
+	financialRiskService financialrisk.Service,
+	housingService housing.Service,
+	lifePensionService lifepension.Service,
+	patrimonialService patrimonial.Service,
+) App {
+	return App{
+		host:           host,
+		op:             op,
+		sessions:       sessions,
+		userService:    userService,
+		consentService: consentService,
+		granter: consentGranter{
+			consentService:                     consentService,
+			autoService:                        autoService,
+			capitalizationTitleService:         capitalizationTitleService,
+			financialAssistanceService:         financialAssistanceService,
+			acceptanceAndBranchesAbroadService: acceptanceAndBranchesAbroadService,
+			financialRiskService:               financialRiskService,
+			housingService:                     housingService,
+			lifePensionService:                 lifePensionService,
+			patrimonialService:                 patrimonialService,
+		},
+		tmpl:       template.Must(template.ParseFS(ui.Templates, "app.html", "consent.html")),
+		sessionKey: newAppSessionKey(),
+	}
+}
+
+func (a App) RegisterRoutes(mux *http.ServeMux) {
+	secureMiddleware := secure.New(secure.Options{
+		STSSeconds:            31536000,
+		STSIncludeSubdomains:  true,
+		STSPreload:            true,
+		FrameDeny:             true,
+		ContentTypeNosniff:    true,
+		BrowserXssFilter:      true,
+		ContentSecurityPolicy: "default-src 'self'; script-src 'self' $NONCE; style-src 'self' $NONCE",
+	})
+
+	mux.Handle("GET /app/{orgId}", secureMiddleware.Handler(http.HandlerFunc(a.loginHandler)))
+	mux.Handle("POST /app/{orgId}", secureMiddleware.Handler(http.HandlerFunc(a.requestsHandler)))
+	mux.Handle("GET /app/ciba/{authReqId}", secureMiddleware.Handler(http.HandlerFunc(a.requestHandler)))
+	mux.Handle("POST /app/ciba/{authReqId}", secureMiddleware.Handler(http.HandlerFunc(a.answerHandler)))
+}
+
+type appPage struct {
+	ActionURL string
+	// LoggedIn is set once the user signs in and the pending requests are listed.
+	LoggedIn bool
+	Requests []appRequest
+	Message  string
+	Error    string
+	// CSRFToken protects the sign in form.
+	CSRFToken string
+}
+
+type appRequest struct {
+	URL         string
+	ClientName  string
+	ConsentID   string
+	Permissions consent.Permissions
+	ExpiresAt   timeutil.DateTime
+}
+
+func (a App) loginHandler(w http.ResponseWriter, r *http.Request) {
+	p, err := a.loginPage(w, r, r.PathValue("orgId"))
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+	a.render(w, "app", p)
+}
+
+func (a App) requestsHandler(w http.ResponseWriter, r *http.Request) {
+	orgID := r.PathValue("orgId")
+	p, err := a.loginPage(w, r, orgID)
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	if !csrf.Valid(r) {
+		p.Error = "Your session expired, please try again."
+		a.render(w, "app", p)
+		return
+	}
+
+	u, err := a.userService.Authenticate(r.Context(), r.PostFormValue(formParamUsername), r.PostFormValue(formParamPassword), orgID)
+	if err != nil {
+		slog.InfoContext(r.Context(), "invalid app credentials", "error", err)
+		p.Error = "Invalid credentials."
+		if errors.Is(err, user.ErrLocked) {
+			p.Error = "Your account is locked."
+		}
+		a.render(w, "app", p)
+		return
+	}
+
+	sessions, err := a.sessions.PendingCIBASessions(r.Context(), u.ID.String(), orgID)
+	if err != nil {
+		slog.ErrorContext(r.Context(), "could not fetch the pending ciba requests", "error", err)
+		p.Error = "Something went wrong, please try again later."
+		a.render(w, "app", p)
+		return
+	}
+
+	a.setSession(w, u.ID.String(), orgID)
+	p.LoggedIn = true
+	for _, as := range sessions {
+		req := appRequest{
+			URL:        a.host + "/app/ciba/" + as.CIBAAuthID,
+			ClientName: as.ClientID,
+			ConsentID:  as.StoredParameter(sessionParamConsentID).(string),
+			ExpiresAt:  timeutil.ParseTimestamp(as.ExpiresAtTimestamp),
+		}
+		if client, err := a.op.Client(r.Context(), as.ClientID); err == nil && client.Name != "" {
+			req.ClientName = client.Name
+		}
+		if c, err := a.consentService.Consent(r.Context(), req.ConsentID, orgID); err == nil {
+			req.Permissions = c.Permissions
+		}
+		p.Requests = append(p.Requests, req)
+	}
+	a.render(w, "app", p)
+}
+
+func (a App) requestHandler(w http.ResponseWriter, r *http.Request) {
+	as, c, err := a.pendingRequest(r, r.PathValue("authReqId"))
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	userID := as.StoredParameter(sessionParamUserID).(string)
+	orgID := as.StoredParameter(OrgIDKey).(string)
+	p, err := a.granter.page(r.Context(), c, userID, orgID)
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	p.CSRFToken, err = csrf.Token(w, r)
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+	p.FormAction = a.host + r.URL.Path
+	p.Nonce = secure.CSPNonce(r.Context())
+	a.render(w, "consent", p)
+}
+
+func (a App) answerHandler(w http.ResponseWriter, r *http.Request) {
+	if !csrf.Valid(r) {
+		a.renderError(w, r, errAppSessionExpired)
+		return
+	}
+
+	authReqID := r.PathValue("authReqId")
+	as, c, err := a.pendingRequest(r, authReqID)
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	userID := as.StoredParameter(sessionParamUserID).(string)
+	orgID := as.StoredParameter(OrgIDKey).(string)
+	p, err := a.loginPage(w, r, orgID)
+	if err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	if r.PostFormValue(formParamConsent) != "true" {
+		reasonAdditionalInfo := "user manually rejected consent"
+		_ = a.consentService.Reject(r.Context(), c.ID.String(), orgID, consent.Rejection{
+			By:                   consent.RejectedByUser,
+			ReasonCode:           consent.RejectionReasonCodeCustomerManuallyRejected,
+			ReasonAdditionalInfo: &reasonAdditionalInfo,
+		})
+
+		as.StoreParameter(sessionParamCIBAStatus, cibaStatusDenied)
+		if err := a.sessions.Save(r.Context(), as); err != nil {
+			a.renderError(w, r, err)
+			return
+		}
+
+		if err := a.op.NotifyCIBAFailure(r.Context(), authReqID, goidc.NewError(goidc.ErrorCodeAccessDenied, "the user denied the request")); err != nil {
+			slog.InfoContext(r.Context(), "could not notify the client about the ciba denial", "error", err)
+		}
+		p.Message = "The request was denied."
+		a.render(w, "app", p)
+		return
+	}
+
+	if err := a.granter.grant(r.Context(), c, userID, orgID, r.Form); err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	grantAuthorization(as)
+	as.StoreParameter(sessionParamCIBAStatus, cibaStatusApproved)
+	if err := a.sessions.Save(r.Context(), as); err != nil {
+		a.renderError(w, r, err)
+		return
+	}
+
+	if err := a.op.NotifyCIBASuccess(r.Context(), authReqID); err != nil {
+		slog.InfoContext(r.Context(), "could not notify the client about the ciba approval", "error", err)
+	}
+	p.Message = "The request was approved, you can go back to the application that requested it."
+	a.render(w, "app", p)
+}
+
+// pendingRequest loads a CIBA request that is still waiting for the user
+// signed in to the app along with its consent.
+func (a App) pendingRequest(r *http.Request, authReqID string) (*goidc.AuthnSession, *consent.Consent, error) {
+	ctx := r.Context()
+	as, err := a.sessions.SessionByCIBAAuthID(ctx, authReqID)
+	if err != nil {
+		return nil, nil, errAppRequestNotFound
+	}
+
+	userID, orgID, ok := a.session(r)
+	if !ok {
+		return nil, nil, errAppSignInRequired
+	}
+	// Requests of other users are reported as not found so their IDs can't be
+	// probed.
+	if userID != as.StoredParameter(sessionParamUserID) || orgID != as.StoredParameter(OrgIDKey) {
+		return nil, nil, errAppRequestNotFound
+	}
+
+	if as.IsExpired() || as.StoredParameter(sessionParamCIBAStatus) != cibaStatusPending {
+		return nil, nil, errAppRequestNotPending
+	}
+
+	c, err := a.consentService.Consent(ctx, as.StoredParameter(sessionParamConsentID).(string), as.StoredParameter(OrgIDKey).(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not fetch the consent of the request: %w", err)
+	}
+
+	return as, c, nil
+}
+
+// setSession signs the user in to the app. The cookie carries the user, the
+// organization and the expiry, signed with the session key.
+func (a App) setSession(w http.ResponseWriter, userID, orgID string) {
+	expiresAt := timeutil.DateTimeNow().Add(appSessionLifetime)
+	payload := userID + "." + orgID + "." + strconv.FormatInt(expiresAt.Unix(), 10)
+	http.SetCookie(w, &http.Cookie{
+		Name:     appSessionCookie,
+		Value:    payload + "." + a.sign(payload),
+		Path:     "/app",
+		Expires:  expiresAt.Time,
+		HttpOnly: true,
+		Secure:   true,
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+// session returns the user and the organization signed in to the app, if the
+// session cookie is valid.
+func (a App) session(r *http.Request) (userID, orgID string, ok bool) {
+	cookie, err := r.Cookie(appSessionCookie)
+	if err != nil {
+		return "", "", false
+	}
+
+	i := strings.LastIndex(cookie.Value, ".")
+	if i < 0 {
+		return "", "", false
+	}
+	payload, signature := cookie.Value[:i], cookie.Value[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(a.sign(payload))) {
+		return "", "", false
+	}
+
+	parts := strings.Split(payload, ".")
+	if len(parts) != 3 {
+		return "", "", false
+	}
+	expiresAt, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil || timeutil.DateTimeNow().Unix() > expiresAt {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+func (a App) sign(payload string) string {
+	mac := hmac.New(sha256.New, a.sessionKey)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func newAppSessionKey() []byte {
+	key := make([]byte, 32)
+	// Read never fails, it crashes the program instead.
+	_, _ = rand.Read(key)
+	return key
+}
+
+// loginPage returns the page where the user signs in to the app of the
+// organization.
+func (a App) loginPage(w http.ResponseWriter, r *http.Request, orgID string) (appPage, error) {
+	token, err := csrf.Token(w, r)
+	if err != nil {
+		return appPage{}, err
+	}
+	return appPage{ActionURL: a.host + "/app/" + orgID, CSRFToken: token}, nil
+}
+
+// renderError shows the errors meant for the user as they are. Any other error
+// is only logged and the user sees a generic message.
+func (a App) renderError(w http.ResponseWriter, r *http.Request, err error) {
+	for _, userErr := range []error{errAppSignInRequired, errAppSessionExpired, errAppRequestNotFound, errAppRequestNotPending} {
+		if errors.Is(err, userErr) {
+			slog.InfoContext(r.Context(), "could not answer the ciba request", "error", err)
+			a.render(w, "app", appPage{Error: err.Error()})
+			return
+		}
+	}
+
+	slog.ErrorContext(r.Context(), "could not answer the ciba request", "error", err)
+	a.render(w, "app", appPage{Error: "Something went wrong, please try again later."})
+}
+
+func (a App) render(w http.ResponseWriter, name string, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := a.tmpl.ExecuteTemplate(w, name+".html", data); err != nil {
+		slog.Error("could not render the app page", "error", err)
+	}
+}
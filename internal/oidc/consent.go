@@ -0,0 +1,235 @@
+package oidc
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/luikyv/mock-insurer/internal/acceptancebranchesabroad"
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/capitalizationtitle"
+	"github.com/luikyv/mock-insurer/internal/consent"
+	"github.com/luikyv/mock-insurer/internal/financialassistance"
+	"github.com/luikyv/mock-insurer/internal/financialrisk"
+	"github.com/luikyv/mock-insurer/internal/housing"
+	"github.com/luikyv/mock-insurer/internal/lifepension"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/patrimonial"
+)
+
+// consentGranter authorizes consents along with the resources the user chose
+// to share. It is used both by the authorization flow in the browser and by the
+// insurer app during CIBA.
+type consentGranter struct {
+	consentService                     consent.Service
+	autoService                        auto.Service
+	capitalizationTitleService         capitalizationtitle.Service
+	financialAssistanceService         financialassistance.Service
+	acceptanceAndBranchesAbroadService acceptancebranchesabroad.Service
+	financialRiskService               financialrisk.Service
+	housingService                     housing.Service
+	lifePensionService                 lifepension.Service
+	patrimonialService                 patrimonial.Service
+}
+
+// consentPage is rendered by the consent.html template.
+type consentPage struct {
+	FormAction string
+	// CSRFToken is only set on the pages served by the app, since the
+	// authorization flow is already bound to its callback ID.
+	CSRFToken                           string
+	UserCPF                             string
+	BusinessCNPJ                        string
+	Nonce                               string
+	CustomerPersonalInfo                bool
+	CustomerBusinessInfo                bool
+	AutoPolicies                        []*auto.Policy
+	CapitalizationTitlePlans            []*capitalizationtitle.Plan
+	FinancialAssistanceContracts        []*financialassistance.Contract
+	AcceptanceAndBranchesAbroadPolicies []*acceptancebranchesabroad.Policy
+	FinancialRiskPolicies               []*financialrisk.Policy
+	HousingPolicies                     []*housing.Policy
+	LifePensionContracts                []*lifepension.Contract
+	PatrimonialPolicies                 []*patrimonial.Policy
+}
+
+// page loads the resources of the user the consent can share, so the user can
+// choose which ones to share.
+func (g consentGranter) page(ctx context.Context, c *consent.Consent, userID, orgID string) (consentPage, error) {
+	p := consentPage{
+		UserCPF: c.UserIdentification,
+	}
+
+	if c.Permissions.HasCustomerPersonalPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with customer personal information")
+		p.CustomerPersonalInfo = true
+	}
+
+	if c.Permissions.HasCustomerBusinessPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with customer business information")
+		p.CustomerBusinessInfo = true
+	}
+
+	if c.Permissions.HasAutoPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with auto policies")
+		policies, err := g.autoService.Policies(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's auto policies", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's auto policies")
+		}
+		p.AutoPolicies = policies.Records
+	}
+
+	if c.Permissions.HasCapitalizationTitlePermissions() {
+		slog.InfoContext(ctx, "rendering consent page with capitalization title plans")
+		plans, err := g.capitalizationTitleService.Plans(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's capitalization title plans", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's capitalization title plans")
+		}
+		p.CapitalizationTitlePlans = plans.Records
+	}
+
+	if c.Permissions.HasFinancialAssistancePermissions() {
+		slog.InfoContext(ctx, "rendering consent page with financial assistance contracts")
+		contracts, err := g.financialAssistanceService.Contracts(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's financial assistance contracts", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's financial assistance contracts")
+		}
+		p.FinancialAssistanceContracts = contracts.Records
+	}
+
+	if c.Permissions.HasAcceptanceAndBranchesAbroadPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with acceptance and branches abroad policies")
+		policies, err := g.acceptanceAndBranchesAbroadService.Policies(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's acceptance and branches abroad policies", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's acceptance and branches abroad policies")
+		}
+		p.AcceptanceAndBranchesAbroadPolicies = policies.Records
+	}
+
+	if c.Permissions.HasFinancialRiskPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with financial risk policies")
+		policies, err := g.financialRiskService.Policies(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's financial risk policies", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's financial risk policies")
+		}
+		p.FinancialRiskPolicies = policies.Records
+	}
+
+	if c.Permissions.HasHousingPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with housing policies")
+		policies, err := g.housingService.Policies(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's housing policies", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's housing policies")
+		}
+		p.HousingPolicies = policies.Records
+	}
+
+	if c.Permissions.HasLifePensionPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with life pension contracts")
+		contracts, err := g.lifePensionService.Contracts(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's life pension contracts", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's life pension contracts")
+		}
+		p.LifePensionContracts = contracts.Records
+	}
+
+	if c.Permissions.HasPatrimonialPermissions() {
+		slog.InfoContext(ctx, "rendering consent page with patrimonial policies")
+		policies, err := g.patrimonialService.Policies(ctx, userID, orgID, page.NewPagination(nil, nil))
+		if err != nil {
+			slog.ErrorContext(ctx, "could not load the user's patrimonial policies", "error", err)
+			return consentPage{}, fmt.Errorf("could not load the user's patrimonial policies")
+		}
+		p.PatrimonialPolicies = policies.Records
+	}
+	return p, nil
+}
+
+// grant authorizes the consent and the resources the user chose to share.
+func (g consentGranter) grant(ctx context.Context, c *consent.Consent, userID, orgID string, form url.Values) error {
+	slog.InfoContext(ctx, "authorizing consent")
+	if err := g.consentService.Authorize(ctx, c); err != nil {
+		return err
+	}
+
+	if c.Permissions.HasAutoPermissions() {
+		autoPolicyIDs := form[formParamAutoPolicyIDs]
+		slog.InfoContext(ctx, "authorizing auto policies", "auto policies", autoPolicyIDs)
+		if err := g.autoService.Authorize(ctx, autoPolicyIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize auto policies", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasCapitalizationTitlePermissions() {
+		capitalizationTitlePlanIDs := form[formParamCapitalizationTitlePlanIDs]
+		slog.InfoContext(ctx, "authorizing capitalization title plans", "capitalization title plans", capitalizationTitlePlanIDs)
+		if err := g.capitalizationTitleService.Authorize(ctx, capitalizationTitlePlanIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize capitalization title plans", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasFinancialAssistancePermissions() {
+		financialAssistanceContractIDs := form[formParamFinancialAssistanceContractIDs]
+		slog.InfoContext(ctx, "authorizing financial assistance contracts", "financial assistance contracts", financialAssistanceContractIDs)
+		if err := g.financialAssistanceService.Authorize(ctx, financialAssistanceContractIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize financial assistance contracts", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasAcceptanceAndBranchesAbroadPermissions() {
+		acceptanceAndBranchesAbroadPolicyIDs := form[formParamAcceptanceAndBranchesAbroadPolicyIDs]
+		slog.InfoContext(ctx, "authorizing acceptance and branches abroad policies", "acceptance and branches abroad policies", acceptanceAndBranchesAbroadPolicyIDs)
+		if err := g.acceptanceAndBranchesAbroadService.Authorize(ctx, acceptanceAndBranchesAbroadPolicyIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize acceptance and branches abroad policies", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasFinancialRiskPermissions() {
+		financialRiskPolicyIDs := form[formParamFinancialRiskPolicyIDs]
+		slog.InfoContext(ctx, "authorizing financial risk policies", "financial risk policies", financialRiskPolicyIDs)
+		if err := g.financialRiskService.Authorize(ctx, financialRiskPolicyIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize financial risk policies", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasHousingPermissions() {
+		housingPolicyIDs := form[formParamHousingPolicyIDs]
+		slog.InfoContext(ctx, "authorizing housing policies", "housing policies", housingPolicyIDs)
+		if err := g.housingService.Authorize(ctx, housingPolicyIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize housing policies", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasLifePensionPermissions() {
+		lifePensionContractIDs := form[formParamLifePensionContractIDs]
+		slog.InfoContext(ctx, "authorizing life pension contracts", "life pension contracts", lifePensionContractIDs)
+		if err := g.lifePensionService.Authorize(ctx, lifePensionContractIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize life pension contracts", "error", err)
+			return err
+		}
+	}
+
+	if c.Permissions.HasPatrimonialPermissions() {
+		patrimonialPolicyIDs := form[formParamPatrimonialPolicyIDs]
+		slog.InfoContext(ctx, "authorizing patrimonial policies", "patrimonial policies", patrimonialPolicyIDs)
+		if err := g.patrimonialService.Authorize(ctx, patrimonialPolicyIDs, userID, c.ID.String(), orgID); err != nil {
+			slog.InfoContext(ctx, "could not authorize patrimonial policies", "error", err)
+			return err
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package oidc
 
 import (
+	"context"
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"github.com/go-jose/go-jose/v4"
 	"github.com/go-jose/go-jose/v4/jwt"
 	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/client"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 )
 
@@ -28,9 +30,10 @@ var (
 )
 
 type DCRConfig struct {
-	Scopes       []goidc.Scope
-	KeyStoreHost string
-	SSIssuer     string
+	Scopes        []goidc.Scope
+	KeyStoreHost  string
+	SSIssuer      string
+	ClientService client.Service
 }
 
 func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
@@ -39,7 +42,7 @@ func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
 		scopeIDs = append(scopeIDs, scope.ID)
 	}
 
-	return func(r *http.Request, _ string, c *goidc.ClientMeta) error {
+	return func(r *http.Request, id string, c *goidc.ClientMeta) error {
 		clientCert, err := ClientCert(r)
 		if err != nil {
 			return goidc.WrapError(goidc.ErrorCodeInvalidClientMetadata, "certificate not informed", err)
@@ -50,7 +53,7 @@ func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
 			return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "software statement is required")
 		}
 
-		jwks, err := fetchSoftwareStatementJWKS(config.KeyStoreHost)
+		jwks, err := fetchSoftwareStatementJWKS(r.Context(), config.KeyStoreHost)
 		if err != nil {
 			return goidc.NewError(goidc.ErrorCodeInternalError, "could not fetch the keystore jwks")
 		}
@@ -96,6 +99,23 @@ func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
 			return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "organization id mismatch")
 		}
 
+		// Updates must present a software statement of the same software and
+		// organization the client was registered with.
+		if r.Method == http.MethodPut {
+			existing, err := config.ClientService.Client(r.Context(), id)
+			if err != nil {
+				return goidc.WrapError(goidc.ErrorCodeInternalError, "could not load the client", err)
+			}
+
+			if existing.Data.CustomAttribute(SoftwareIDKey) != ss.SoftwareID {
+				return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "the software id cannot be changed")
+			}
+
+			if existing.OrgID != ss.OrgID {
+				return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "the organization id cannot be changed")
+			}
+		}
+
 		if c.PublicJWKSURI != ss.SoftwareJWKSURI {
 			return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "jwks uri mismatch")
 		}
@@ -106,11 +126,13 @@ func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
 			}
 		}
 
-		if webhookURIs, ok := c.CustomAttribute(WebhookURIsKey).([]string); ok {
-			for _, webhookURI := range webhookURIs {
-				if !slices.Contains(ss.SoftwareAPIWebhookURIs, webhookURI) {
-					return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "webhook uri not allowed")
-				}
+		webhookURIs, err := clientWebhookURIs(c)
+		if err != nil {
+			return err
+		}
+		for _, webhookURI := range webhookURIs {
+			if !slices.Contains(ss.SoftwareAPIWebhookURIs, webhookURI) {
+				return goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "webhook uri not allowed")
 			}
 		}
 
@@ -129,18 +151,39 @@ func DCRFunc(config DCRConfig) goidc.HandleDynamicClientFunc {
 			OrgIDKey:      ss.OrgID,
 			SoftwareIDKey: ss.SoftwareID,
 		}
-		if uris, ok := c.CustomAttribute(WebhookURIsKey).([]any); ok {
-			webhookURIs := make([]string, len(uris))
-			for i, uri := range uris {
-				webhookURIs[i] = uri.(string)
-			}
+		if webhookURIs != nil {
 			attrs[WebhookURIsKey] = webhookURIs
 		}
+		if rotation, ok := c.CustomAttribute(RefreshTokenRotationKey).(bool); ok {
+			attrs[RefreshTokenRotationKey] = rotation
+		}
 		c.CustomAttributes = attrs
 		return nil
 	}
 }
 
+// clientWebhookURIs returns the webhook URIs informed in the client metadata.
+func clientWebhookURIs(c *goidc.ClientMeta) ([]string, error) {
+	switch uris := c.CustomAttribute(WebhookURIsKey).(type) {
+	case nil:
+		return nil, nil
+	case []string:
+		return uris, nil
+	case []any:
+		webhookURIs := make([]string, len(uris))
+		for i, uri := range uris {
+			s, ok := uri.(string)
+			if !ok {
+				return nil, goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "invalid webhook uris")
+			}
+			webhookURIs[i] = s
+		}
+		return webhookURIs, nil
+	default:
+		return nil, goidc.NewError(goidc.ErrorCodeInvalidClientMetadata, "invalid webhook uris")
+	}
+}
+
 func extractUID(cert *x509.Certificate) string {
 	for _, name := range cert.Subject.Names {
 		if name.Type.String() == oidUID {
@@ -150,7 +193,7 @@ func extractUID(cert *x509.Certificate) string {
 	return ""
 }
 
-func fetchSoftwareStatementJWKS(keystoreHost string) (goidc.JSONWebKeySet, error) {
+func fetchSoftwareStatementJWKS(ctx context.Context, keystoreHost string) (goidc.JSONWebKeySet, error) {
 	ssJWKSMu.Lock()
 	defer ssJWKSMu.Unlock()
 
@@ -158,7 +201,12 @@ func fetchSoftwareStatementJWKS(keystoreHost string) (goidc.JSONWebKeySet, error
 		return *ssJWKSCache, nil
 	}
 
-	resp, err := http.Get(keystoreHost + "/openinsurance.jwks")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, keystoreHost+"/openinsurance.jwks", nil)
+	if err != nil {
+		return goidc.JSONWebKeySet{}, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return goidc.JSONWebKeySet{}, err
 	}
@@ -2,8 +2,10 @@ package oidc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/consent"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"gorm.io/gorm"
 )
@@ -17,15 +19,9 @@ func NewGrantSessionManager(db *gorm.DB) GrantSessionManager {
 }
 
 func (m GrantSessionManager) Save(ctx context.Context, gs *goidc.GrantSession) error {
-	grant := &Grant{
-		ID:           gs.ID,
-		TokenID:      gs.TokenID,
-		RefreshToken: gs.RefreshToken,
-		AuthCode:     gs.AuthCode,
-		ExpiresAt:    timeutil.ParseTimestamp(gs.ExpiresAtTimestamp),
-		Data:         *gs,
-		UpdatedAt:    timeutil.DateTimeNow(),
-		OrgID:        gs.AdditionalTokenClaims[OrgIDKey].(string),
+	grant, err := newGrant(ctx, gs)
+	if err != nil {
+		return err
 	}
 	return m.db.WithContext(ctx).Save(grant).Error
 }
@@ -46,6 +42,15 @@ func (m GrantSessionManager) DeleteByAuthCode(ctx context.Context, code string)
 	return m.db.WithContext(ctx).Where("auth_code = ?", code).Delete(&Grant{}).Error
 }
 
+// DeleteByConsentID revokes the grants issued for a consent, invalidating their
+// access and refresh tokens.
+func (m GrantSessionManager) DeleteByConsentID(ctx context.Context, consentID string) error {
+	if err := m.db.WithContext(ctx).Where("consent_id = ?", consentID).Delete(&Grant{}).Error; err != nil {
+		return fmt.Errorf("could not delete the grants of consent %s: %w", consentID, err)
+	}
+	return nil
+}
+
 func (m GrantSessionManager) grant(ctx context.Context, tx *gorm.DB) (*goidc.GrantSession, error) {
 	var grant Grant
 	if err := tx.WithContext(ctx).First(&grant).Error; err != nil {
@@ -59,8 +64,10 @@ type Grant struct {
 	TokenID      string
 	RefreshToken string
 	AuthCode     string
-	ExpiresAt    timeutil.DateTime
-	Data         goidc.GrantSession `gorm:"serializer:json"`
+	// ConsentID is set for grants bound to a consent scope.
+	ConsentID *string
+	ExpiresAt timeutil.DateTime
+	Data      goidc.GrantSession `gorm:"serializer:json"`
 
 	OrgID     string
 	CreatedAt timeutil.DateTime
@@ -70,3 +77,63 @@ type Grant struct {
 func (Grant) TableName() string {
 	return "oauth_grants"
 }
+
+// newGrant maps the grant session to its record. When the grant is refreshed,
+// a new refresh token is issued only for clients registered with
+// refresh_token_rotation, the others keep the one they have.
+func newGrant(ctx context.Context, gs *goidc.GrantSession) (*Grant, error) {
+	if gs.GrantType == goidc.GrantRefreshToken {
+		if issued, ok := ctx.Value(ctxKeyRefreshToken).(*issuedRefreshToken); ok {
+			if gs.Store[RefreshTokenRotationKey] == true {
+				token, err := newRefreshToken()
+				if err != nil {
+					return nil, err
+				}
+				gs.RefreshToken = token
+			}
+			issued.value = gs.RefreshToken
+		}
+	}
+
+	if gs.RefreshToken != "" {
+		// The provider issues refresh tokens with a fixed lifetime, but the ones
+		// bound to a consent must not outlive it.
+		if expiresAt, ok := storedInt(gs.Store[refreshTokenExpiresAtKey]); ok {
+			gs.ExpiresAtTimestamp = expiresAt
+		}
+	}
+
+	return &Grant{
+		ID:           gs.ID,
+		TokenID:      gs.TokenID,
+		RefreshToken: gs.RefreshToken,
+		AuthCode:     gs.AuthCode,
+		ConsentID:    consentID(gs.GrantedScopes),
+		ExpiresAt:    timeutil.ParseTimestamp(gs.ExpiresAtTimestamp),
+		Data:         *gs,
+		UpdatedAt:    timeutil.DateTimeNow(),
+		OrgID:        gs.AdditionalTokenClaims[OrgIDKey].(string),
+	}, nil
+}
+
+// storedInt reads an integer from a grant or session store, which holds
+// float64 values once loaded back from the database.
+func storedInt(v any) (int, bool) {
+	switch t := v.(type) {
+	case int:
+		return t, true
+	case int64:
+		return int(t), true
+	case float64:
+		return int(t), true
+	default:
+		return 0, false
+	}
+}
+
+func consentID(scopes string) *string {
+	if id, ok := consent.IDFromScopes(scopes); ok {
+		return &id
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package oidc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/testutil"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+func TestStoredInt(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  any
+		want   int
+		wantOK bool
+	}{
+		{name: "int", value: 10, want: 10, wantOK: true},
+		{name: "int64", value: int64(10), want: 10, wantOK: true},
+		{name: "float64 loaded from the database", value: float64(10), want: 10, wantOK: true},
+		{name: "missing value", value: nil},
+		{name: "string", value: "10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			got, ok := storedInt(tt.value)
+
+			// Then.
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("got %d and %t, want %d and %t", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNewGrant(t *testing.T) {
+	tests := []struct {
+		name          string
+		grantType     goidc.GrantType
+		store         map[string]any
+		refreshing    bool
+		wantExpiresAt int
+		wantRotated   bool
+	}{
+		{
+			name:          "should keep the provider lifetime when not bound to a consent",
+			grantType:     goidc.GrantAuthorizationCode,
+			store:         map[string]any{},
+			wantExpiresAt: 3600,
+		},
+		{
+			name:          "should not outlive the consent",
+			grantType:     goidc.GrantAuthorizationCode,
+			store:         map[string]any{refreshTokenExpiresAtKey: float64(1800)},
+			wantExpiresAt: 1800,
+		},
+		{
+			name:          "should keep the refresh token of clients that don't rotate",
+			grantType:     goidc.GrantRefreshToken,
+			store:         map[string]any{RefreshTokenRotationKey: false, refreshTokenExpiresAtKey: float64(1800)},
+			refreshing:    true,
+			wantExpiresAt: 1800,
+		},
+		{
+			name:          "should rotate the refresh token of clients that rotate",
+			grantType:     goidc.GrantRefreshToken,
+			store:         map[string]any{RefreshTokenRotationKey: true},
+			refreshing:    true,
+			wantExpiresAt: 3600,
+			wantRotated:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given.
+			gs := &goidc.GrantSession{
+				ID:                 uuid.NewString(),
+				RefreshToken:       "refresh-token",
+				ExpiresAtTimestamp: 3600,
+				GrantInfo: goidc.GrantInfo{
+					GrantType:             tt.grantType,
+					AdditionalTokenClaims: map[string]any{OrgIDKey: testutil.OrgID},
+					Store:                 tt.store,
+				},
+			}
+			ctx := context.Background()
+			issued := &issuedRefreshToken{}
+			if tt.refreshing {
+				ctx = context.WithValue(ctx, ctxKeyRefreshToken, issued)
+			}
+
+			// When.
+			grant, err := newGrant(ctx, gs)
+
+			// Then.
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := grant.ExpiresAt.Unix(); got != int64(tt.wantExpiresAt) {
+				t.Errorf("got expiration %d, want %d", got, tt.wantExpiresAt)
+			}
+			if rotated := grant.RefreshToken != "refresh-token"; rotated != tt.wantRotated {
+				t.Errorf("got refresh token %s, want rotated %t", grant.RefreshToken, tt.wantRotated)
+			}
+			if tt.refreshing && issued.value != grant.RefreshToken {
+				t.Errorf("got refresh token %s returned, want %s", issued.value, grant.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestSessionByRefreshToken(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation bool
+		wantErr  error
+	}{
+		{
+			name:     "should accept the old refresh token of clients that don't rotate",
+			rotation: false,
+		},
+		{
+			name:     "should refuse the old refresh token of clients that rotate",
+			rotation: true,
+			wantErr:  gorm.ErrRecordNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given.
+			manager := NewGrantSessionManager(testutil.NewDB(t))
+			gs := &goidc.GrantSession{
+				ID:                 uuid.NewString(),
+				TokenID:            uuid.NewString(),
+				RefreshToken:       uuid.NewString(),
+				ExpiresAtTimestamp: timeutil.Timestamp() + 3600,
+				GrantInfo: goidc.GrantInfo{
+					GrantType:             goidc.GrantAuthorizationCode,
+					AdditionalTokenClaims: map[string]any{OrgIDKey: testutil.OrgID},
+					Store:                 map[string]any{RefreshTokenRotationKey: tt.rotation},
+				},
+			}
+			if err := manager.Save(context.Background(), gs); err != nil {
+				t.Fatalf("failed to save grant: %v", err)
+			}
+			oldRefreshToken := gs.RefreshToken
+
+			gs.GrantType = goidc.GrantRefreshToken
+			ctx := context.WithValue(context.Background(), ctxKeyRefreshToken, &issuedRefreshToken{})
+			if err := manager.Save(ctx, gs); err != nil {
+				t.Fatalf("failed to refresh grant: %v", err)
+			}
+
+			// When.
+			_, err := manager.SessionByRefreshToken(context.Background(), oldRefreshToken)
+
+			// Then.
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -13,6 +13,16 @@ const (
 	OrgIDKey       = "org_id"
 	SoftwareIDKey  = "software_id"
 	WebhookURIsKey = "webhook_uris"
+	// ConsentIDKey is the token claim with the ID of the consent the token
+	// was issued for.
+	ConsentIDKey = "consent_id"
+	// RefreshTokenRotationKey is the client metadata that enables refresh
+	// token rotation for the client.
+	RefreshTokenRotationKey = "refresh_token_rotation"
+)
+
+const (
+	refreshTokenExpiresAtKey = "refresh_token_expires_at"
 )
 
 type SoftwareStatement struct {
@@ -0,0 +1,187 @@
+package oidc
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"html/template"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"github.com/unrolled/secure"
+)
+
+const (
+	sessionParamACR         = "acr"
+	sessionParamOTP         = "otp"
+	sessionParamOTPAttempts = "otp_attempts"
+
+	formParamOTPAction = "otp-action"
+	formParamOTP       = "otp"
+
+	otpActionVerify = "verify"
+	otpActionSkip   = "skip"
+
+	otpMaxAttempts = 3
+
+	// errorCodeUnmetAuthenticationRequirements is returned when the client
+	// requires an authentication level the user didn't achieve, as defined by
+	// OpenID Connect Core Unmet Authentication Requirements 1.0.
+	errorCodeUnmetAuthenticationRequirements goidc.ErrorCode = "unmet_authentication_requirements"
+)
+
+// otpStep asks for a one-time password as a second factor when the client
+// requests LOA3. The code is shown on the page or, if mailboxPath is set,
+// appended to that file. Users who don't confirm it remain at LOA2, unless LOA3
+// is essential, in which case the flow fails.
+func otpStep(baseURL string, tmpl *template.Template, mailboxPath string) goidc.AuthnFunc {
+	type Page struct {
+		BaseURL    string
+		CallbackID string
+		Nonce      string
+		// Code is only shown when it is not sent to the mailbox.
+		Code    string
+		CanSkip bool
+		Error   string
+	}
+
+	renderOTPPage := func(w http.ResponseWriter, r *http.Request, as *goidc.AuthnSession, essential bool, errMsg string) (goidc.Status, error) {
+		p := Page{
+			BaseURL:    baseURL,
+			CallbackID: as.CallbackID,
+			Nonce:      secure.CSPNonce(r.Context()),
+			CanSkip:    !essential,
+			Error:      errMsg,
+		}
+		if mailboxPath == "" {
+			p.Code, _ = as.StoredParameter(sessionParamOTP).(string)
+		}
+		return renderPage(w, tmpl, "otp", p)
+	}
+
+	loa3NotAchieved := func(r *http.Request, essential bool, reason string) (goidc.Status, error) {
+		if essential {
+			slog.InfoContext(r.Context(), "loa3 is essential but was not achieved", "reason", reason)
+			return goidc.StatusFailure, goidc.NewError(errorCodeUnmetAuthenticationRequirements, reason)
+		}
+		slog.InfoContext(r.Context(), "continuing with loa2", "reason", reason)
+		return goidc.StatusSuccess, nil
+	}
+
+	return func(w http.ResponseWriter, r *http.Request, as *goidc.AuthnSession) (goidc.Status, error) {
+		requested, essential := loa3Requested(as)
+		if !requested {
+			return goidc.StatusSuccess, nil
+		}
+
+		code, _ := as.StoredParameter(sessionParamOTP).(string)
+		if code == "" {
+			code, err := newOTP()
+			if err != nil {
+				return goidc.StatusFailure, err
+			}
+			as.StoreParameter(sessionParamOTP, code)
+
+			if mailboxPath != "" {
+				cpf, _ := as.StoredParameter(sessionParamCPF).(string)
+				if err := sendOTP(mailboxPath, cpf, code); err != nil {
+					return goidc.StatusFailure, err
+				}
+			}
+
+			slog.InfoContext(r.Context(), "rendering otp page")
+			return renderOTPPage(w, r, as, essential, "")
+		}
+
+		switch r.PostFormValue(formParamOTPAction) {
+		case otpActionVerify:
+		case otpActionSkip:
+			return loa3NotAchieved(r, essential, "the user did not inform the one-time password")
+		default:
+			return renderOTPPage(w, r, as, essential, "")
+		}
+
+		if subtle.ConstantTimeCompare([]byte(r.PostFormValue(formParamOTP)), []byte(code)) != 1 {
+			attempts, _ := storedInt(as.StoredParameter(sessionParamOTPAttempts))
+			attempts++
+			if attempts >= otpMaxAttempts {
+				return loa3NotAchieved(r, essential, "too many invalid one-time passwords")
+			}
+
+			as.StoreParameter(sessionParamOTPAttempts, attempts)
+			return renderOTPPage(w, r, as, essential, "invalid code")
+		}
+
+		slog.InfoContext(r.Context(), "otp step finished successfully")
+		as.StoreParameter(sessionParamACR, string(ACROpenInsuranceLOA3))
+		return goidc.StatusSuccess, nil
+	}
+}
+
+// loa3Requested reports whether the client asked for LOA3, either through
+// acr_values or the acr claim, and whether it doesn't accept LOA2 instead.
+func loa3Requested(as *goidc.AuthnSession) (requested, essential bool) {
+	if slices.Contains(strings.Fields(as.ACRValues), string(ACROpenInsuranceLOA3)) {
+		requested = true
+	}
+
+	if as.Claims == nil {
+		return requested, essential
+	}
+
+	var claims []goidc.ClaimObjectInfo
+	if c, ok := as.Claims.IDTokenClaim(goidc.ClaimACR); ok {
+		claims = append(claims, c)
+	}
+	if c, ok := as.Claims.UserInfoClaim(goidc.ClaimACR); ok {
+		claims = append(claims, c)
+	}
+
+	for _, c := range claims {
+		acrs := c.Values
+		if c.Value != "" {
+			acrs = append(acrs, c.Value)
+		}
+
+		if !slices.Contains(acrs, string(ACROpenInsuranceLOA3)) {
+			continue
+		}
+
+		requested = true
+		if c.IsEssential && !slices.Contains(acrs, string(ACROpenInsuranceLOA2)) {
+			essential = true
+		}
+	}
+	return requested, essential
+}
+
+func newOTP() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1_000_000))
+	if err != nil {
+		return "", fmt.Errorf("could not generate the one-time password: %w", err)
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// sendOTP appends the one-time password to the mailbox file.
+func sendOTP(mailboxPath, cpf, code string) (err error) {
+	f, err := os.OpenFile(mailboxPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("could not open the otp mailbox: %w", err)
+	}
+	defer func() {
+		err = errors.Join(err, f.Close())
+	}()
+
+	if _, err := fmt.Fprintf(f, "%s cpf=%s code=%s\n", timeutil.DateTimeNow(), cpf, code); err != nil {
+		return fmt.Errorf("could not write to the otp mailbox: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package oidc
+
+import (
+	"testing"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+)
+
+func TestLOA3Requested(t *testing.T) {
+	tests := []struct {
+		name          string
+		session       *goidc.AuthnSession
+		wantRequested bool
+		wantEssential bool
+	}{
+		{
+			name:    "nothing requested",
+			session: &goidc.AuthnSession{},
+		},
+		{
+			name: "loa2 in acr_values",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				ACRValues: string(ACROpenInsuranceLOA2),
+			}},
+		},
+		{
+			name: "loa3 in acr_values",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				ACRValues: string(ACROpenInsuranceLOA2) + " " + string(ACROpenInsuranceLOA3),
+			}},
+			wantRequested: true,
+		},
+		{
+			name: "voluntary loa3 claim",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				Claims: &goidc.ClaimsObject{IDToken: map[string]goidc.ClaimObjectInfo{
+					goidc.ClaimACR: {Value: string(ACROpenInsuranceLOA3)},
+				}},
+			}},
+			wantRequested: true,
+		},
+		{
+			name: "essential loa3 claim",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				Claims: &goidc.ClaimsObject{IDToken: map[string]goidc.ClaimObjectInfo{
+					goidc.ClaimACR: {IsEssential: true, Value: string(ACROpenInsuranceLOA3)},
+				}},
+			}},
+			wantRequested: true,
+			wantEssential: true,
+		},
+		{
+			name: "essential claim accepting loa2",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				Claims: &goidc.ClaimsObject{UserInfo: map[string]goidc.ClaimObjectInfo{
+					goidc.ClaimACR: {IsEssential: true, Values: []string{string(ACROpenInsuranceLOA3), string(ACROpenInsuranceLOA2)}},
+				}},
+			}},
+			wantRequested: true,
+		},
+		{
+			name: "essential userinfo loa3 claim",
+			session: &goidc.AuthnSession{AuthorizationParameters: goidc.AuthorizationParameters{
+				Claims: &goidc.ClaimsObject{UserInfo: map[string]goidc.ClaimObjectInfo{
+					goidc.ClaimACR: {IsEssential: true, Values: []string{string(ACROpenInsuranceLOA3)}},
+				}},
+			}},
+			wantRequested: true,
+			wantEssential: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			requested, essential := loa3Requested(tt.session)
+
+			// Then.
+			if requested != tt.wantRequested || essential != tt.wantEssential {
+				t.Errorf("got requested %t and essential %t, want %t and %t", requested, essential, tt.wantRequested, tt.wantEssential)
+			}
+		})
+	}
+}
@@ -20,11 +20,13 @@ import (
 	"github.com/luikyv/mock-insurer/internal/financialrisk"
 	"github.com/luikyv/mock-insurer/internal/housing"
 	"github.com/luikyv/mock-insurer/internal/lifepension"
-	"github.com/luikyv/mock-insurer/internal/page"
 	"github.com/luikyv/mock-insurer/internal/patrimonial"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"github.com/luikyv/mock-insurer/internal/user"
 	"github.com/unrolled/secure"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -45,14 +47,13 @@ const (
 	formParamHousingPolicyIDs                     = "housing-policies"
 	formParamLifePensionContractIDs               = "life-pension-contracts"
 	formParamPatrimonialPolicyIDs                 = "patrimonial-policies"
-
-	correctPassword = "P@ssword01"
 )
 
 // TODO: Validate that the resources (accounts, ...) sent belong to the user.
 // TODO: Pass the template as a parameter.
 func Policies(
 	baseURL string,
+	otpMailboxPath string,
 	userService user.Service,
 	consentService consent.Service,
 	autoService auto.Service,
@@ -78,22 +79,21 @@ func Policies(
 				as.StoreParameter(OrgIDKey, c.CustomAttribute(OrgIDKey))
 				return true
 			},
-			goidc.NewAuthnStep("setup", validateConsentStep(consentService)),
-			goidc.NewAuthnStep("login", loginStep(baseURL, tmpl, userService)),
-			goidc.NewAuthnStep("consent", grantConsentStep(
-				baseURL, tmpl,
-				userService,
-				consentService,
-				autoService,
-				capitalizationTitleService,
-				financialAssistanceService,
-				acceptanceAndBranchesAbroadService,
-				financialRiskService,
-				housingService,
-				lifePensionService,
-				patrimonialService,
-			)),
-			goidc.NewAuthnStep("finish", grantAuthorizationStep()),
+			tracedStep("setup", validateConsentStep(consentService)),
+			tracedStep("login", loginStep(baseURL, tmpl, userService)),
+			tracedStep("otp", otpStep(baseURL, tmpl, otpMailboxPath)),
+			tracedStep("consent", grantConsentStep(baseURL, tmpl, userService, consentService, consentGranter{
+				consentService:                     consentService,
+				autoService:                        autoService,
+				capitalizationTitleService:         capitalizationTitleService,
+				financialAssistanceService:         financialAssistanceService,
+				acceptanceAndBranchesAbroadService: acceptanceAndBranchesAbroadService,
+				financialRiskService:               financialRiskService,
+				housingService:                     housingService,
+				lifePensionService:                 lifePensionService,
+				patrimonialService:                 patrimonialService,
+			})),
+			tracedStep("finish", grantAuthorizationStep()),
 		),
 	}
 }
@@ -139,16 +139,20 @@ func loginStep(baseURL string, tmpl *template.Template, userService user.Service
 
 		orgID := as.StoredParameter(OrgIDKey).(string)
 		username := r.PostFormValue(formParamUsername)
-		u, err := userService.User(r.Context(), user.Query{Username: username}, orgID)
-		if err != nil {
-			slog.InfoContext(r.Context(), "could not fetch user", "error", err)
-			return renderLoginErrorPage(w, r, as, "invalid username")
-		}
-
 		password := r.PostFormValue(formParamPassword)
-		if password != correctPassword {
-			slog.InfoContext(r.Context(), "invalid password")
-			return renderLoginErrorPage(w, r, as, "invalid credentials")
+		u, err := userService.Authenticate(r.Context(), username, password, orgID)
+		if err != nil {
+			slog.InfoContext(r.Context(), "could not authenticate user", "error", err)
+			switch {
+			case errors.Is(err, user.ErrNotFound):
+				return renderLoginErrorPage(w, r, as, "invalid username")
+			case errors.Is(err, user.ErrLocked):
+				return renderLoginErrorPage(w, r, as, "account locked after too many failed attempts")
+			case errors.Is(err, user.ErrInvalidCredentials):
+				return renderLoginErrorPage(w, r, as, "invalid credentials")
+			default:
+				return goidc.StatusFailure, err
+			}
 		}
 
 		slog.InfoContext(r.Context(), "login step finished successfully", "user_id", u.ID, "user_cpf", u.CPF)
@@ -182,134 +186,19 @@ func grantConsentStep(
 	tmpl *template.Template,
 	userService user.Service,
 	consentService consent.Service,
-	autoService auto.Service,
-	capitalizationTitleService capitalizationtitle.Service,
-	financialAssistanceService financialassistance.Service,
-	acceptanceAndBranchesAbroadService acceptancebranchesabroad.Service,
-	financialRiskService financialrisk.Service,
-	housingService housing.Service,
-	lifePensionService lifepension.Service,
-	patrimonialService patrimonial.Service,
+	granter consentGranter,
 ) goidc.AuthnFunc {
-	type Page struct {
-		BaseURL                             string
-		CallbackID                          string
-		UserCPF                             string
-		BusinessCNPJ                        string
-		Nonce                               string
-		CustomerPersonalInfo                bool
-		CustomerBusinessInfo                bool
-		AutoPolicies                        []*auto.Policy
-		CapitalizationTitlePlans            []*capitalizationtitle.Plan
-		FinancialAssistanceContracts        []*financialassistance.Contract
-		AcceptanceAndBranchesAbroadPolicies []*acceptancebranchesabroad.Policy
-		FinancialRiskPolicies               []*financialrisk.Policy
-		HousingPolicies                     []*housing.Policy
-		LifePensionContracts                []*lifepension.Contract
-		PatrimonialPolicies                 []*patrimonial.Policy
-	}
-
 	renderConsentPage := func(w http.ResponseWriter, r *http.Request, as *goidc.AuthnSession, c *consent.Consent) (goidc.Status, error) {
-		consentPage := Page{
-			BaseURL:    baseURL,
-			UserCPF:    c.UserIdentification,
-			CallbackID: as.CallbackID,
-			Nonce:      secure.CSPNonce(r.Context()),
-		}
-
 		userID := as.StoredParameter(sessionParamUserID).(string)
 		orgID := as.StoredParameter(OrgIDKey).(string)
-
-		if c.Permissions.HasCustomerPersonalPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with customer personal information")
-			consentPage.CustomerPersonalInfo = true
-		}
-
-		if c.Permissions.HasCustomerBusinessPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with customer business information")
-			consentPage.CustomerBusinessInfo = true
-		}
-
-		if c.Permissions.HasAutoPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with auto policies")
-			policies, err := autoService.Policies(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's auto policies", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's auto policies")
-			}
-			consentPage.AutoPolicies = policies.Records
-		}
-
-		if c.Permissions.HasCapitalizationTitlePermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with capitalization title plans")
-			plans, err := capitalizationTitleService.Plans(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's capitalization title plans", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's capitalization title plans")
-			}
-			consentPage.CapitalizationTitlePlans = plans.Records
-		}
-
-		if c.Permissions.HasFinancialAssistancePermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with financial assistance contracts")
-			contracts, err := financialAssistanceService.Contracts(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's financial assistance contracts", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's financial assistance contracts")
-			}
-			consentPage.FinancialAssistanceContracts = contracts.Records
-		}
-
-		if c.Permissions.HasAcceptanceAndBranchesAbroadPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with acceptance and branches abroad policies")
-			policies, err := acceptanceAndBranchesAbroadService.Policies(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's acceptance and branches abroad policies", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's acceptance and branches abroad policies")
-			}
-			consentPage.AcceptanceAndBranchesAbroadPolicies = policies.Records
-		}
-
-		if c.Permissions.HasFinancialRiskPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with financial risk policies")
-			policies, err := financialRiskService.Policies(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's financial risk policies", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's financial risk policies")
-			}
-			consentPage.FinancialRiskPolicies = policies.Records
-		}
-
-		if c.Permissions.HasHousingPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with housing policies")
-			policies, err := housingService.Policies(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's housing policies", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's housing policies")
-			}
-			consentPage.HousingPolicies = policies.Records
-		}
-
-		if c.Permissions.HasLifePensionPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with life pension contracts")
-			contracts, err := lifePensionService.Contracts(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's life pension contracts", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's life pension contracts")
-			}
-			consentPage.LifePensionContracts = contracts.Records
+		p, err := granter.page(r.Context(), c, userID, orgID)
+		if err != nil {
+			return goidc.StatusFailure, err
 		}
 
-		if c.Permissions.HasPatrimonialPermissions() {
-			slog.InfoContext(r.Context(), "rendering consent page with patrimonial policies")
-			policies, err := patrimonialService.Policies(r.Context(), userID, orgID, page.NewPagination(nil, nil))
-			if err != nil {
-				slog.ErrorContext(r.Context(), "could not load the user's patrimonial policies", "error", err)
-				return goidc.StatusFailure, fmt.Errorf("could not load the user's patrimonial policies")
-			}
-			consentPage.PatrimonialPolicies = policies.Records
-		}
-		return renderPage(w, tmpl, "consent", consentPage)
+		p.FormAction = baseURL + "/authorize/" + as.CallbackID + "/consent"
+		p.Nonce = secure.CSPNonce(r.Context())
+		return renderPage(w, tmpl, "consent", p)
 	}
 
 	return func(w http.ResponseWriter, r *http.Request, as *goidc.AuthnSession) (goidc.Status, error) {
@@ -363,82 +252,9 @@ func grantConsentStep(
 			return goidc.StatusFailure, errors.New("consent not granted")
 		}
 
-		slog.InfoContext(r.Context(), "authorizing consent")
-		if err := consentService.Authorize(r.Context(), c); err != nil {
-			return goidc.StatusFailure, err
-		}
-
 		userID := as.StoredParameter(sessionParamUserID).(string)
-		if c.Permissions.HasAutoPermissions() {
-			autoPolicyIDs := r.Form[formParamAutoPolicyIDs]
-			slog.InfoContext(r.Context(), "authorizing auto policies", "auto policies", autoPolicyIDs)
-			if err := autoService.Authorize(r.Context(), autoPolicyIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize auto policies", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasCapitalizationTitlePermissions() {
-			capitalizationTitlePlanIDs := r.Form[formParamCapitalizationTitlePlanIDs]
-			slog.InfoContext(r.Context(), "authorizing capitalization title plans", "capitalization title plans", capitalizationTitlePlanIDs)
-			if err := capitalizationTitleService.Authorize(r.Context(), capitalizationTitlePlanIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize capitalization title plans", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasFinancialAssistancePermissions() {
-			financialAssistanceContractIDs := r.Form[formParamFinancialAssistanceContractIDs]
-			slog.InfoContext(r.Context(), "authorizing financial assistance contracts", "financial assistance contracts", financialAssistanceContractIDs)
-			if err := financialAssistanceService.Authorize(r.Context(), financialAssistanceContractIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize financial assistance contracts", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasAcceptanceAndBranchesAbroadPermissions() {
-			acceptanceAndBranchesAbroadPolicyIDs := r.Form[formParamAcceptanceAndBranchesAbroadPolicyIDs]
-			slog.InfoContext(r.Context(), "authorizing acceptance and branches abroad policies", "acceptance and branches abroad policies", acceptanceAndBranchesAbroadPolicyIDs)
-			if err := acceptanceAndBranchesAbroadService.Authorize(r.Context(), acceptanceAndBranchesAbroadPolicyIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize acceptance and branches abroad policies", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasFinancialRiskPermissions() {
-			financialRiskPolicyIDs := r.Form[formParamFinancialRiskPolicyIDs]
-			slog.InfoContext(r.Context(), "authorizing financial risk policies", "financial risk policies", financialRiskPolicyIDs)
-			if err := financialRiskService.Authorize(r.Context(), financialRiskPolicyIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize financial risk policies", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasHousingPermissions() {
-			housingPolicyIDs := r.Form[formParamHousingPolicyIDs]
-			slog.InfoContext(r.Context(), "authorizing housing policies", "housing policies", housingPolicyIDs)
-			if err := housingService.Authorize(r.Context(), housingPolicyIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize housing policies", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasLifePensionPermissions() {
-			lifePensionContractIDs := r.Form[formParamLifePensionContractIDs]
-			slog.InfoContext(r.Context(), "authorizing life pension contracts", "life pension contracts", lifePensionContractIDs)
-			if err := lifePensionService.Authorize(r.Context(), lifePensionContractIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize life pension contracts", "error", err)
-				return goidc.StatusFailure, err
-			}
-		}
-
-		if c.Permissions.HasPatrimonialPermissions() {
-			patrimonialPolicyIDs := r.Form[formParamPatrimonialPolicyIDs]
-			slog.InfoContext(r.Context(), "authorizing patrimonial policies", "patrimonial policies", patrimonialPolicyIDs)
-			if err := patrimonialService.Authorize(r.Context(), patrimonialPolicyIDs, userID, c.ID.String(), orgID); err != nil {
-				slog.InfoContext(r.Context(), "could not authorize patrimonial policies", "error", err)
-				return goidc.StatusFailure, err
-			}
+		if err := granter.grant(r.Context(), c, userID, orgID, r.Form); err != nil {
+			return goidc.StatusFailure, err
 		}
 		return goidc.StatusSuccess, nil
 	}
@@ -447,30 +263,53 @@ func grantConsentStep(
 func grantAuthorizationStep() goidc.AuthnFunc {
 	return func(_ http.ResponseWriter, r *http.Request, as *goidc.AuthnSession) (goidc.Status, error) {
 		slog.InfoContext(r.Context(), "auth flow finished, filling oauth session")
+		grantAuthorization(as)
+		return goidc.StatusSuccess, nil
+	}
+}
 
-		sub := as.StoredParameter(sessionParamUserID).(string)
-		if businessID := as.StoredParameter(sessionParamBusinessID); businessID != nil {
-			sub = businessID.(string)
-		}
-		as.SetUserID(sub)
-		as.GrantScopes(as.Scopes)
-		as.SetIDTokenClaimACR(ACROpenInsuranceLOA2)
-		as.SetIDTokenClaimAuthTime(timeutil.Timestamp())
-
-		if as.Claims != nil {
-			if slices.Contains(as.Claims.IDTokenEssentials(), goidc.ClaimACR) {
-				as.SetIDTokenClaimACR(ACROpenInsuranceLOA2)
-			}
+// grantAuthorization fills the session of a user who authorized the consent, so
+// tokens can be issued for it.
+func grantAuthorization(as *goidc.AuthnSession) {
+	sub := as.StoredParameter(sessionParamUserID).(string)
+	if businessID := as.StoredParameter(sessionParamBusinessID); businessID != nil {
+		sub = businessID.(string)
+	}
+	as.SetUserID(sub)
+	as.GrantScopes(as.Scopes)
 
-			if slices.Contains(as.Claims.UserInfoEssentials(), goidc.ClaimACR) {
-				as.SetUserInfoClaimACR(ACROpenInsuranceLOA2)
-			}
-		}
+	// Users reach LOA3 only by confirming the one-time password.
+	acr := ACROpenInsuranceLOA2
+	if as.StoredParameter(sessionParamACR) == string(ACROpenInsuranceLOA3) {
+		acr = ACROpenInsuranceLOA3
+	}
+	as.SetIDTokenClaimACR(acr)
+	as.SetIDTokenClaimAuthTime(timeutil.Timestamp())
 
-		return goidc.StatusSuccess, nil
+	if as.Claims != nil && slices.Contains(as.Claims.UserInfoEssentials(), goidc.ClaimACR) {
+		as.SetUserInfoClaimACR(acr)
 	}
 }
 
+// tracedStep creates an authentication step recording a span for each time it
+// runs.
+func tracedStep(name string, fn goidc.AuthnFunc) goidc.AuthnStep {
+	return goidc.NewAuthnStep(name, func(w http.ResponseWriter, r *http.Request, as *goidc.AuthnSession) (goidc.Status, error) {
+		ctx, span := tracer.Start(r.Context(), "oidc.authn."+name, trace.WithAttributes(
+			attribute.String("oidc.client_id", as.ClientID),
+		))
+		defer span.End()
+
+		status, err := fn(w, r.WithContext(ctx), as)
+		span.SetAttributes(attribute.String("oidc.authn.status", string(status)))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		return status, err
+	})
+}
+
 func renderPage(w http.ResponseWriter, tmpl *template.Template, name string, data any) (goidc.Status, error) {
 	if !strings.HasSuffix(name, ".html") {
 		name = name + ".html"
@@ -0,0 +1,105 @@
+package oidc
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/luikyv/go-oidc/pkg/goidc"
+)
+
+type contextKey string
+
+// ctxKeyRefreshToken holds the refresh token to be returned when a grant is
+// refreshed.
+const ctxKeyRefreshToken contextKey = "refresh_token"
+
+const refreshTokenCharset = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// issuedRefreshToken is filled by the grant session manager with the refresh
+// token of the grant refreshed.
+type issuedRefreshToken struct {
+	value string
+}
+
+// RefreshTokenMiddleware returns the refresh token of the grant in the
+// responses to refresh token requests. The provider doesn't rotate refresh
+// tokens, so rotation is decided per client when the grant session is saved
+// and clients registered without refresh_token_rotation get the same refresh
+// token back.
+func RefreshTokenMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/token" || r.PostFormValue("grant_type") != string(goidc.GrantRefreshToken) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		token := &issuedRefreshToken{}
+		rec := &bufferedResponse{header: http.Header{}, status: http.StatusOK}
+		next.ServeHTTP(rec, r.WithContext(context.WithValue(r.Context(), ctxKeyRefreshToken, token)))
+
+		body := rec.body.Bytes()
+		if rec.status == http.StatusOK && token.value != "" {
+			if b, err := withRefreshToken(body, token.value); err != nil {
+				slog.ErrorContext(r.Context(), "could not add the refresh token to the response", "error", err)
+			} else {
+				body = b
+				rec.header.Set("Content-Length", strconv.Itoa(len(body)))
+			}
+		}
+
+		for name, values := range rec.header {
+			w.Header()[name] = values
+		}
+		w.WriteHeader(rec.status)
+		_, _ = w.Write(body)
+	})
+}
+
+func withRefreshToken(body []byte, token string) ([]byte, error) {
+	var resp map[string]any
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	if err := dec.Decode(&resp); err != nil {
+		return nil, fmt.Errorf("could not decode the token response: %w", err)
+	}
+	resp["refresh_token"] = token
+	return json.Marshal(resp)
+}
+
+func newRefreshToken() (string, error) {
+	token := make([]byte, goidc.RefreshTokenLength)
+	charsetLen := big.NewInt(int64(len(refreshTokenCharset)))
+	for i := range token {
+		n, err := rand.Int(rand.Reader, charsetLen)
+		if err != nil {
+			return "", fmt.Errorf("could not generate the refresh token: %w", err)
+		}
+		token[i] = refreshTokenCharset[n.Int64()]
+	}
+	return string(token), nil
+}
+
+type bufferedResponse struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (b *bufferedResponse) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponse) WriteHeader(status int) {
+	b.status = status
+}
+
+func (b *bufferedResponse) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
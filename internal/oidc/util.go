@@ -9,16 +9,23 @@ import (
 	"log/slog"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/go-oidc/pkg/provider"
 	"github.com/luikyv/mock-insurer/internal/consent"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
 	HeaderClientCert = "X-Client-Cert"
 )
 
+var tracer = otel.Tracer("github.com/luikyv/mock-insurer/internal/oidc")
+
 func TokenOptionsFunc() goidc.TokenOptionsFunc {
 	return func(_ context.Context, gi goidc.GrantInfo, c *goidc.Client) goidc.TokenOptions {
 		return goidc.NewJWTTokenOptions(goidc.PS256, 900)
@@ -26,20 +33,46 @@ func TokenOptionsFunc() goidc.TokenOptionsFunc {
 }
 
 func HandleGrantFunc(op *provider.Provider, consentService consent.Service) goidc.HandleGrantFunc {
-	verifyConsent := func(ctx context.Context, id, orgID string) error {
+	verifyConsent := func(ctx context.Context, gi *goidc.GrantInfo, id, orgID string) error {
 		c, err := consentService.Consent(ctx, id, orgID)
 		if err != nil {
+			if errors.Is(err, consent.ErrNotFound) {
+				return goidc.NewError(goidc.ErrorCodeInvalidGrant, "consent not found")
+			}
 			return fmt.Errorf("could not fetch consent for verifying grant: %w", err)
 		}
 
 		if c.Status != consent.StatusAuthorized {
+			// Grants are revoked when their consent is rejected, but refresh
+			// tokens issued before that must not outlive the consent either.
+			if gi.GrantType == goidc.GrantRefreshToken {
+				return goidc.NewError(goidc.ErrorCodeInvalidGrant, "the consent of the refresh token is no longer authorized")
+			}
 			return goidc.NewError(goidc.ErrorCodeInvalidGrant, "consent is not authorized")
 		}
 
+		// Refresh tokens for phase 2 consents live as long as the consent.
+		// Phase 3 consents keep the default refresh token lifetime.
+		if !c.IsPhase3() {
+			gi.Store[refreshTokenExpiresAtKey] = c.ExpiresAt.Unix()
+		}
 		return nil
 	}
 
-	return func(r *http.Request, gi *goidc.GrantInfo) error {
+	return func(r *http.Request, gi *goidc.GrantInfo) (err error) {
+		ctx, span := tracer.Start(r.Context(), "oidc.grant", trace.WithAttributes(
+			attribute.String("oidc.client_id", gi.ClientID),
+			attribute.String("oidc.grant_type", string(gi.GrantType)),
+		))
+		defer func() {
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+			span.End()
+		}()
+		r = r.WithContext(ctx)
+
 		if gi.AdditionalTokenClaims == nil {
 			gi.AdditionalTokenClaims = make(map[string]any)
 		}
@@ -52,14 +85,28 @@ func HandleGrantFunc(op *provider.Provider, consentService consent.Service) goid
 		orgID := client.CustomAttribute(OrgIDKey).(string)
 		gi.AdditionalTokenClaims[OrgIDKey] = orgID
 
+		if gi.Store == nil {
+			gi.Store = make(map[string]any)
+		}
+		gi.Store[RefreshTokenRotationKey] = client.CustomAttribute(RefreshTokenRotationKey) == true
+
 		if consentID, _ := consent.IDFromScopes(gi.ActiveScopes); consentID != "" {
-			return verifyConsent(r.Context(), consentID, orgID)
+			gi.AdditionalTokenClaims[ConsentIDKey] = consentID
+			return verifyConsent(r.Context(), gi, consentID, orgID)
 		}
 
 		return nil
 	}
 }
 
+// IsClientAllowedTokenIntrospectionFunc allows only the clients informed to
+// introspect tokens.
+func IsClientAllowedTokenIntrospectionFunc(clientIDs []string) goidc.IsClientAllowedTokenInstrospectionFunc {
+	return func(c *goidc.Client, _ goidc.TokenInfo) bool {
+		return slices.Contains(clientIDs, c.ID)
+	}
+}
+
 func HandlePARSessionFunc() goidc.HandleSessionFunc {
 	return func(r *http.Request, as *goidc.AuthnSession, c *goidc.Client) error {
 		as.StoreParameter(OrgIDKey, c.CustomAttribute(OrgIDKey))
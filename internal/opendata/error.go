@@ -0,0 +1,8 @@
+package opendata
+
+import "errors"
+
+var (
+	ErrProductNotFound = errors.New("product not found")
+	ErrChannelNotFound = errors.New("channel not found")
+)
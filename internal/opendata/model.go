@@ -0,0 +1,172 @@
+package opendata
+
+import (
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+// Brand identifies the institution publishing the open data.
+type Brand struct {
+	Name        string
+	CompanyName string
+	CNPJ        string
+}
+
+type ProductType string
+
+const (
+	ProductTypeAuto                ProductType = "AUTO"
+	ProductTypeHome                ProductType = "HOME"
+	ProductTypeLifePension         ProductType = "LIFE_PENSION"
+	ProductTypeCapitalizationTitle ProductType = "CAPITALIZATION_TITLE"
+)
+
+var productTypes = []ProductType{
+	ProductTypeAuto,
+	ProductTypeHome,
+	ProductTypeLifePension,
+	ProductTypeCapitalizationTitle,
+}
+
+// Product is an entry of the product catalogue published by the products and
+// services API.
+type Product struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Type      ProductType
+	Code      string
+	Data      ProductData `gorm:"serializer:json"`
+	CreatedAt timeutil.DateTime
+	UpdatedAt timeutil.DateTime
+}
+
+func (Product) TableName() string {
+	return "opendata_products"
+}
+
+func (p *Product) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == uuid.Nil {
+		p.ID = uuid.New()
+	}
+	return nil
+}
+
+type ProductData struct {
+	Name               string           `json:"name"`
+	Coverages          []Coverage       `json:"coverages"`
+	AssistanceServices []string         `json:"assistanceServices,omitempty"`
+	TargetAudiences    []TargetAudience `json:"targetAudiences"`
+	SusepProcessNumber string           `json:"susepProcessNumber"`
+	TermsURL           string           `json:"termsUrl"`
+	// MinimumAmount is the minimum contribution of life pension plans and the
+	// minimum price of capitalization titles in BRL.
+	MinimumAmount *string `json:"minimumAmount,omitempty"`
+	// TermMonths is the duration of capitalization titles and the minimum
+	// accumulation period of life pension plans.
+	TermMonths *int `json:"termMonths,omitempty"`
+}
+
+type Coverage struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+}
+
+type TargetAudience string
+
+const (
+	TargetAudienceNaturalPerson TargetAudience = "PESSOA_NATURAL"
+	TargetAudienceLegalPerson   TargetAudience = "PESSOA_JURIDICA"
+)
+
+type ChannelType string
+
+const (
+	ChannelTypeBranch            ChannelType = "BRANCH"
+	ChannelTypeElectronic        ChannelType = "ELECTRONIC"
+	ChannelTypePhone             ChannelType = "PHONE"
+	ChannelTypeIntermediary      ChannelType = "INTERMEDIARY"
+	ChannelTypeReferencedNetwork ChannelType = "REFERENCED_NETWORK"
+)
+
+var channelTypes = []ChannelType{
+	ChannelTypeBranch,
+	ChannelTypeElectronic,
+	ChannelTypePhone,
+	ChannelTypeIntermediary,
+	ChannelTypeReferencedNetwork,
+}
+
+// Channel is a service channel published by the channels API.
+type Channel struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Type      ChannelType
+	Code      string
+	Data      ChannelData `gorm:"serializer:json"`
+	CreatedAt timeutil.DateTime
+	UpdatedAt timeutil.DateTime
+}
+
+func (Channel) TableName() string {
+	return "opendata_channels"
+}
+
+func (c *Channel) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == uuid.Nil {
+		c.ID = uuid.New()
+	}
+	return nil
+}
+
+type ChannelData struct {
+	Name string `json:"name"`
+	// Category details the type of the channel, e.g. FILIAL for branches,
+	// INTERNET or SAC for electronic and phone channels, CORRETOR_DE_SEGUROS
+	// for intermediaries and OFICINA for the referenced network.
+	Category *string `json:"category,omitempty"`
+	// DocumentNumber is the CPF or CNPJ of intermediaries and the CNPJ of the
+	// referenced network.
+	DocumentNumber *string        `json:"documentNumber,omitempty"`
+	Address        *Address       `json:"address,omitempty"`
+	Phones         []Phone        `json:"phones,omitempty"`
+	Email          *string        `json:"email,omitempty"`
+	URLs           []string       `json:"urls,omitempty"`
+	Availability   []Availability `json:"availability,omitempty"`
+	Services       []string       `json:"services,omitempty"`
+}
+
+type Address struct {
+	Address            string `json:"address"`
+	DistrictName       string `json:"districtName"`
+	TownName           string `json:"townName"`
+	CountrySubDivision string `json:"countrySubDivision"`
+	PostCode           string `json:"postCode"`
+}
+
+type Phone struct {
+	CountryCallingCode string  `json:"countryCallingCode"`
+	AreaCode           *string `json:"areaCode,omitempty"`
+	Number             string  `json:"number"`
+}
+
+var branchCategories = []string{
+	"POSTO_DE_ATENDIMENTO",
+	"UNIDADE_ADMINISTRATIVA_DESMEMBRADA",
+	"SEDE",
+	"FILIAL",
+}
+
+var weekdays = []string{
+	"DOMINGO",
+	"SEGUNDA_FEIRA",
+	"TERCA_FEIRA",
+	"QUARTA_FEIRA",
+	"QUINTA_FEIRA",
+	"SEXTA_FEIRA",
+	"SABADO",
+}
+
+type Availability struct {
+	Weekday     string `json:"weekday"`
+	OpeningTime string `json:"openingTime"`
+	ClosingTime string `json:"closingTime"`
+}
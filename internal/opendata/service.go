@@ -0,0 +1,152 @@
+package opendata
+
+import (
+	"context"
+	"slices"
+
+	"github.com/luikyv/mock-insurer/internal/errorutil"
+	"github.com/luikyv/mock-insurer/internal/page"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
+)
+
+type Service struct {
+	storage Storage
+	brand   Brand
+}
+
+func NewService(db *gorm.DB, brand Brand) Service {
+	return Service{storage: storage{db: db}, brand: brand}
+}
+
+func (s Service) Brand() Brand {
+	return s.brand
+}
+
+// SaveProduct creates the product or replaces the one with the same type and
+// code.
+func (s Service) SaveProduct(ctx context.Context, product *Product) error {
+	if err := validateProduct(product); err != nil {
+		return err
+	}
+
+	product.CreatedAt = timeutil.DateTimeNow()
+	product.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.saveProduct(ctx, product)
+}
+
+func (s Service) Products(ctx context.Context, productType ProductType, pag page.Pagination) (page.Page[*Product], error) {
+	return s.storage.products(ctx, productType, pag)
+}
+
+func (s Service) DeleteProduct(ctx context.Context, productType ProductType, code string) error {
+	return s.storage.deleteProduct(ctx, productType, code)
+}
+
+// SaveChannel creates the channel or replaces the one with the same type and
+// code.
+func (s Service) SaveChannel(ctx context.Context, channel *Channel) error {
+	if err := validateChannel(channel); err != nil {
+		return err
+	}
+
+	channel.CreatedAt = timeutil.DateTimeNow()
+	channel.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.saveChannel(ctx, channel)
+}
+
+func (s Service) Channels(ctx context.Context, channelType ChannelType, pag page.Pagination) (page.Page[*Channel], error) {
+	return s.storage.channels(ctx, channelType, pag)
+}
+
+func (s Service) DeleteChannel(ctx context.Context, channelType ChannelType, code string) error {
+	return s.storage.deleteChannel(ctx, channelType, code)
+}
+
+func validateProduct(product *Product) error {
+	if !slices.Contains(productTypes, product.Type) {
+		return errorutil.Format("invalid product type %s", product.Type)
+	}
+
+	if product.Code == "" {
+		return errorutil.New("product code is required")
+	}
+
+	if product.Data.Name == "" {
+		return errorutil.New("product name is required")
+	}
+
+	if len(product.Data.TargetAudiences) == 0 {
+		return errorutil.New("at least one target audience is required")
+	}
+
+	for _, audience := range product.Data.TargetAudiences {
+		if audience != TargetAudienceNaturalPerson && audience != TargetAudienceLegalPerson {
+			return errorutil.Format("invalid target audience %s", audience)
+		}
+	}
+
+	return nil
+}
+
+func validateChannel(channel *Channel) error {
+	if !slices.Contains(channelTypes, channel.Type) {
+		return errorutil.Format("invalid channel type %s", channel.Type)
+	}
+
+	if channel.Code == "" {
+		return errorutil.New("channel code is required")
+	}
+
+	if channel.Data.Name == "" {
+		return errorutil.New("channel name is required")
+	}
+
+	switch channel.Type {
+	case ChannelTypeBranch:
+		if channel.Data.Address == nil {
+			return errorutil.New("branches require an address")
+		}
+		if channel.Data.Category != nil && !slices.Contains(branchCategories, *channel.Data.Category) {
+			return errorutil.Format("invalid branch category %s", *channel.Data.Category)
+		}
+	case ChannelTypeElectronic:
+		if channel.Data.Category == nil || len(channel.Data.URLs) == 0 {
+			return errorutil.New("electronic channels require a category and at least one url")
+		}
+	case ChannelTypePhone:
+		if channel.Data.Category == nil || len(channel.Data.Phones) == 0 {
+			return errorutil.New("phone channels require a category and at least one phone")
+		}
+	case ChannelTypeIntermediary:
+		if channel.Data.Category == nil || !isDocumentNumber(channel.Data.DocumentNumber, 11, 14) {
+			return errorutil.New("intermediaries require a category and a cpf or cnpj document number")
+		}
+	case ChannelTypeReferencedNetwork:
+		if channel.Data.Category == nil || !isDocumentNumber(channel.Data.DocumentNumber, 14) {
+			return errorutil.New("the referenced network requires a category and a cnpj document number")
+		}
+	}
+
+	for _, availability := range channel.Data.Availability {
+		if !slices.Contains(weekdays, availability.Weekday) {
+			return errorutil.Format("invalid weekday %s", availability.Weekday)
+		}
+	}
+
+	return nil
+}
+
+// isDocumentNumber reports whether the document has only digits and one of
+// the lengths informed.
+func isDocumentNumber(document *string, lengths ...int) bool {
+	if document == nil || !slices.Contains(lengths, len(*document)) {
+		return false
+	}
+	for _, r := range *document {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,89 @@
+package opendata
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/luikyv/mock-insurer/internal/page"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Storage interface {
+	saveProduct(context.Context, *Product) error
+	products(ctx context.Context, productType ProductType, pag page.Pagination) (page.Page[*Product], error)
+	deleteProduct(ctx context.Context, productType ProductType, code string) error
+	saveChannel(context.Context, *Channel) error
+	channels(ctx context.Context, channelType ChannelType, pag page.Pagination) (page.Page[*Channel], error)
+	deleteChannel(ctx context.Context, channelType ChannelType, code string) error
+}
+
+type storage struct {
+	db *gorm.DB
+}
+
+func (s storage) saveProduct(ctx context.Context, product *Product) error {
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "type"}, {Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"data", "updated_at"}),
+		}).
+		Create(product).Error
+	if err != nil {
+		return fmt.Errorf("could not save product: %w", err)
+	}
+	return nil
+}
+
+func (s storage) products(ctx context.Context, productType ProductType, pag page.Pagination) (page.Page[*Product], error) {
+	query := s.db.WithContext(ctx).Model(&Product{}).Where("type = ?", productType).Order("code ASC")
+	products, err := page.Paginate[*Product](query, pag)
+	if err != nil {
+		return page.Page[*Product]{}, fmt.Errorf("could not fetch products: %w", err)
+	}
+	return products, nil
+}
+
+func (s storage) deleteProduct(ctx context.Context, productType ProductType, code string) error {
+	tx := s.db.WithContext(ctx).Where("type = ? AND code = ?", productType, code).Delete(&Product{})
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("could not delete product: %w", err)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrProductNotFound
+	}
+	return nil
+}
+
+func (s storage) saveChannel(ctx context.Context, channel *Channel) error {
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "type"}, {Name: "code"}},
+			DoUpdates: clause.AssignmentColumns([]string{"data", "updated_at"}),
+		}).
+		Create(channel).Error
+	if err != nil {
+		return fmt.Errorf("could not save channel: %w", err)
+	}
+	return nil
+}
+
+func (s storage) channels(ctx context.Context, channelType ChannelType, pag page.Pagination) (page.Page[*Channel], error) {
+	query := s.db.WithContext(ctx).Model(&Channel{}).Where("type = ?", channelType).Order("code ASC")
+	channels, err := page.Paginate[*Channel](query, pag)
+	if err != nil {
+		return page.Page[*Channel]{}, fmt.Errorf("could not fetch channels: %w", err)
+	}
+	return channels, nil
+}
+
+func (s storage) deleteChannel(ctx context.Context, channelType ChannelType, code string) error {
+	tx := s.db.WithContext(ctx).Where("type = ? AND code = ?", channelType, code).Delete(&Channel{})
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("could not delete channel: %w", err)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrChannelNotFound
+	}
+	return nil
+}
@@ -83,3 +83,10 @@ func Paginate[T any](query *gorm.DB, pag Pagination) (Page[T], error) {
 
 	return New(records, pag, int(total)), nil
 }
+
+// Slice returns the page of records already loaded in memory.
+func Slice[T any](records []T, pag Pagination) Page[T] {
+	start := min(pag.Offset(), len(records))
+	end := min(start+pag.Limit(), len(records))
+	return New(records[start:end], pag, len(records))
+}
@@ -1,12 +1,13 @@
 package auto
 
 import (
+	"math/big"
+
 	"github.com/google/uuid"
 	"github.com/luikyv/go-oidc/pkg/goidc"
 	"github.com/luikyv/mock-insurer/internal/auto"
 	"github.com/luikyv/mock-insurer/internal/insurer"
 	"github.com/luikyv/mock-insurer/internal/quote"
-	"github.com/luikyv/mock-insurer/internal/strutil"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"gorm.io/gorm"
 )
@@ -16,6 +17,9 @@ var (
 	ScopeLead = goidc.NewScope("quote-auto-lead")
 )
 
+// API identifies the quote auto API in the dynamic fields.
+const API = "quote-auto"
+
 type Quote struct {
 	ID              uuid.UUID `gorm:"primaryKey"`
 	ConsentID       string
@@ -71,6 +75,10 @@ func (q *Quote) GetTermEndDate() timeutil.BrazilDate {
 	return q.Data.TermEndDate
 }
 
+func (q *Quote) GetExpirationDateTime() timeutil.DateTime {
+	return q.Data.ExpirationDateTime
+}
+
 func (q *Quote) SetRejectionReason(rejectionReason string) {
 	q.Data.RejectionReason = &rejectionReason
 }
@@ -117,42 +125,57 @@ func (q *Quote) GetOfferIDs() []string {
 	return offerIDs
 }
 
-func (q *Quote) CreateOffers() {
-	q.Data.Quotes = &[]Offer{
-		{
-			InsurerQuoteID:      uuid.New().String(),
-			SusepProcessNumbers: []string{strutil.Random(50)},
-			Premium: Premium{
-				PaymentsQuantity: "1",
-				TotalAmount: insurer.AmountDetails{
-					Amount:   "100.00",
-					UnitType: insurer.UnitTypeMonetary,
-					Unit: &insurer.Unit{
-						Code:        insurer.UnitCodeReal,
-						Description: insurer.CurrencyBRL,
-					},
-				},
-				TotalNetAmount: insurer.AmountDetails{
-					Amount:   "100.00",
-					UnitType: insurer.UnitTypeMonetary,
-					Unit: &insurer.Unit{
-						Code:        insurer.UnitCodeReal,
-						Description: insurer.CurrencyBRL,
-					},
-				},
-				IOF: insurer.AmountDetails{
-					Amount:   "100.00",
-					UnitType: insurer.UnitTypeMonetary,
-					Unit: &insurer.Unit{
-						Code:        insurer.UnitCodeReal,
-						Description: insurer.CurrencyBRL,
-					},
-				},
-			},
-			Coverages:   []OfferCoverage{},
-			Assistances: []Assistance{},
-		},
+func (q *Quote) GetLicensePlate() *string {
+	if q.Data.InsuredObject == nil {
+		return nil
+	}
+	return q.Data.InsuredObject.LicensePlate
+}
+
+func (q *Quote) GetAmount() string {
+	total := new(big.Rat)
+	if q.Data.Coverages != nil {
+		for _, c := range *q.Data.Coverages {
+			if amount, err := c.MaxLMI.Value(); err == nil {
+				total.Add(total, amount)
+			}
+		}
+	}
+	return total.FloatString(2)
+}
+
+func (q *Quote) GetCustomData() *quote.CustomData {
+	return q.Data.CustomData
+}
+
+func (q *Quote) CreateOffers(n int) {
+	offers := make([]Offer, 0, n)
+	for i := range n {
+		offers = append(offers, q.price(i))
+	}
+	q.Data.Quotes = &offers
+}
+
+func (q *Quote) GetIssuedPolicyID() *string {
+	return q.Data.IssuedPolicyID
+}
+
+func (q *Quote) SetIssuedPolicyID(id string) {
+	q.Data.IssuedPolicyID = &id
+}
+
+// ChosenOffer returns the offer acknowledged by the customer, if any.
+func (q *Quote) ChosenOffer() *Offer {
+	if q.Data.InsurerQuoteID == nil || q.Data.Quotes == nil {
+		return nil
 	}
+
+	for i, o := range *q.Data.Quotes {
+		if o.InsurerQuoteID == *q.Data.InsurerQuoteID {
+			return &(*q.Data.Quotes)[i]
+		}
+	}
+	return nil
 }
 
 func (q *Quote) GetOrgID() string {
@@ -184,6 +207,7 @@ type Data struct {
 	CustomData                 *quote.CustomData    `json:"customData,omitempty"`
 	HistoricalData             *HistoricalData      `json:"historicalData,omitempty"`
 	Quotes                     *[]Offer             `json:"quotes,omitempty"`
+	IssuedPolicyID             *string              `json:"issuedPolicyId,omitempty"`
 }
 
 type Lead struct {
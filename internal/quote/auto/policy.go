@@ -0,0 +1,166 @@
+package auto
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/insurer"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+const policyProductName = "Mock Insurer Auto"
+
+// Policy builds the auto policy issued for the offer the customer chose when
+// acknowledging the quote.
+func (q *Quote) Policy(ownerID uuid.UUID) (*auto.Policy, error) {
+	offer := q.ChosenOffer()
+	if offer == nil {
+		return nil, errors.New("the quote has no chosen offer")
+	}
+
+	issuanceDate := timeutil.BrazilDateNow()
+	maxLMG := new(big.Rat)
+	coverages := make([]auto.Coverage, 0, len(offer.Coverages))
+	objectCoverages := make([]auto.InsuredObjectCoverage, 0, len(offer.Coverages))
+	for i, c := range offer.Coverages {
+		lmi := insurer.NewAmount(new(big.Rat))
+		if c.POS.MaxValue != nil {
+			lmi = *c.POS.MaxValue
+		}
+		if amount, err := lmi.Value(); err == nil && amount.Cmp(maxLMG) > 0 {
+			maxLMG = amount
+		}
+
+		coverages = append(coverages, auto.Coverage{
+			Branch:      &c.Branch,
+			Code:        c.Code,
+			Description: c.Description,
+			Deductible:  c.Deductible,
+			POS:         &c.POS,
+		})
+		objectCoverages = append(objectCoverages, auto.InsuredObjectCoverage{
+			Branch:             c.Branch,
+			Code:               string(c.Code),
+			Description:        c.Description,
+			InternalCode:       c.InternalCode,
+			SusepProcessNumber: offer.SusepProcessNumbers[0],
+			LMI:                lmi,
+			TermStartDate:      q.Data.TermStartDate,
+			TermEndDate:        q.Data.TermEndDate,
+			IsMainCoverage:     i == 0,
+			Feature:            auto.CoverageFeatureMass,
+			Type:               auto.CoverageTypeRegularCommon,
+			PremiumAmount:      offer.Premium.Coverages[i].PremiumAmount,
+			PremiumPeriodicity: premiumPeriodicity(offer.Premium.PaymentsQuantity),
+		})
+	}
+
+	insuredObject := auto.InsuredObject{
+		IdentificationType: auto.AUTOMOVEL,
+		Coverages:          objectCoverages,
+	}
+	if obj := q.Data.InsuredObject; obj != nil {
+		insuredObject.Identification = obj.Identification
+		insuredObject.Modality = obj.Modality
+		insuredObject.AmountReferenceTable = obj.TableUsed
+		insuredObject.OvernightPostCode = &obj.OvernightPostCode
+		insuredObject.Description = obj.Identification
+		if obj.Model != nil {
+			model := obj.Model.Brand + " " + obj.Model.ModelName
+			insuredObject.Model = &model
+			insuredObject.Description = model
+			insuredObject.Year = obj.Model.ModelYear
+		}
+		if len(obj.VehicleUsage) != 0 {
+			insuredObject.VehicleUsage = &obj.VehicleUsage[0]
+		}
+	}
+
+	return &auto.Policy{
+		Data: auto.PolicyData{
+			ProductName:                 policyProductName,
+			DocumentType:                auto.DocumentTypeIndividualAuto,
+			SusepProcessNumber:          &offer.SusepProcessNumbers[0],
+			IssuanceType:                auto.IssuanceTypeOwn,
+			IssuanceDate:                issuanceDate,
+			TermStartDate:               q.Data.TermStartDate,
+			TermEndDate:                 q.Data.TermEndDate,
+			MaxLMG:                      insurer.NewAmount(maxLMG),
+			ProposalID:                  *q.Data.ProtocolNumber,
+			Insureds:                    q.insureds(),
+			InsuredObjects:              []auto.InsuredObject{insuredObject},
+			Coverages:                   coverages,
+			RepairNetwork:               auto.RepairNetworkFreeChoice,
+			RepairedPartsUsageType:      auto.RepairedPartsUsageTypeNew,
+			RepairedPartsClassification: auto.RepairedPartsClassificationOriginal,
+			RepairedPartsNationality:    auto.RepairedPartsNationalityNational,
+			ValidityType:                q.Data.TermType,
+			BonusClass:                  q.Data.BonusClass,
+			Premium: auto.PremiumData{
+				PaymentsQuantity: offer.Premium.PaymentsQuantity,
+				Amount:           offer.Premium.TotalAmount,
+				Coverages:        offer.Premium.Coverages,
+				Payments:         offer.Premium.Payments,
+			},
+		},
+		OwnerID: ownerID,
+		OrgID:   q.OrgID,
+	}, nil
+}
+
+// insureds returns the quote customer as the insured of the policy.
+func (q *Quote) insureds() []auto.Insured {
+	if business := q.Data.Customer.Business; business != nil && business.Identification != nil {
+		id := business.Identification
+		insured := auto.Insured{
+			Identification:     id.CompanyInfo.CNPJ,
+			IdentificationType: insurer.IdentificationTypeCNPJ,
+			Name:               id.BusinessName,
+		}
+		if id.IncorporationDate != nil {
+			insured.BirthDate = *id.IncorporationDate
+		}
+		if addresses := id.Contact.PostalAddresses; len(addresses) != 0 {
+			insured.Address = addresses[0].Address
+			insured.PostCode = addresses[0].PostCode
+			insured.City = addresses[0].TownName
+			insured.State = string(addresses[0].CountrySubDivision)
+			insured.Country = addresses[0].Country
+		}
+		return []auto.Insured{insured}
+	}
+
+	if personal := q.Data.Customer.Personal; personal != nil && personal.Identification != nil {
+		id := personal.Identification
+		insured := auto.Insured{
+			Identification:     id.CPF,
+			IdentificationType: insurer.IdentificationTypeCPF,
+			Name:               id.CivilName,
+		}
+		if id.BirthDate != nil {
+			insured.BirthDate = *id.BirthDate
+		}
+		if addresses := id.Contact.PostalAddresses; len(addresses) != 0 {
+			insured.Address = addresses[0].Address
+			insured.PostCode = addresses[0].PostCode
+			insured.City = addresses[0].TownName
+			insured.State = string(addresses[0].CountrySubDivision)
+			insured.Country = string(addresses[0].Country)
+		}
+		if id.Contact.Emails != nil && len(*id.Contact.Emails) != 0 {
+			insured.Email = (*id.Contact.Emails)[0].Email
+		}
+		return []auto.Insured{insured}
+	}
+
+	return []auto.Insured{}
+}
+
+func premiumPeriodicity(paymentsQuantity string) insurer.PremiumPeriodicity {
+	if paymentsQuantity == "1" {
+		return insurer.PremiumPeriodicityOneTime
+	}
+	return insurer.PremiumPeriodicityMonthly
+}
@@ -0,0 +1,270 @@
+package auto
+
+import (
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/insurer"
+	"github.com/luikyv/mock-insurer/internal/strutil"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+// Pricing rules used to compute the premium of auto quote offers.
+// The premium of each coverage is its max LMI multiplied by the coverage rate
+// and by the vehicle, customer and deductible factors, prorated by the
+// quote's term.
+var (
+	// coverageRates are annual rates applied over the max LMI of each coverage.
+	coverageRates = map[auto.CoverageCode]*big.Rat{
+		auto.CoverageCodeComprehensive:                     big.NewRat(4, 100),
+		auto.CoverageCodeFireTheftBurglary:                 big.NewRat(25, 1000),
+		auto.CoverageCodeTheftBurglary:                     big.NewRat(2, 100),
+		auto.CoverageCodeFire:                              big.NewRat(5, 1000),
+		auto.CoverageCodeFlood:                             big.NewRat(5, 1000),
+		auto.CoverageCodeCollisionPartial:                  big.NewRat(25, 1000),
+		auto.CoverageCodeCollisionFull:                     big.NewRat(3, 100),
+		auto.CoverageCodeOptionalVehicleLiability:          big.NewRat(1, 100),
+		auto.CoverageCodeOptionalDriverLiability:           big.NewRat(1, 100),
+		auto.CoverageCodePassengerAccidentVehicle:          big.NewRat(5, 1000),
+		auto.CoverageCodePassengerAccidentDriver:           big.NewRat(5, 1000),
+		auto.CoverageCodeGlass:                             big.NewRat(15, 1000),
+		auto.CoverageCodeDailyUnavailability:               big.NewRat(3, 100),
+		auto.CoverageCodeLightsHeadlightsRearviewMirrors:   big.NewRat(15, 1000),
+		auto.CoverageCodeAccessoriesEquipment:              big.NewRat(2, 100),
+		auto.CoverageCodeReserveCar:                        big.NewRat(3, 100),
+		auto.CoverageCodeMinorRepairs:                      big.NewRat(2, 100),
+		auto.CoverageCodeGreenCard:                         big.NewRat(1, 100),
+		auto.CoverageCodeLiabilityPassengerCarsNonMercosur: big.NewRat(1, 100),
+	}
+	defaultCoverageRate = big.NewRat(1, 100)
+
+	// deductibleRates are the deductible amounts, as a rate over the max LMI,
+	// for hull coverages. A lower deductible means a higher premium.
+	deductibleRates = map[auto.CoverageDeductibleType]*big.Rat{
+		auto.CoverageDeductibleTypeReduced:   big.NewRat(25, 1000),
+		auto.CoverageDeductibleTypeNormal:    big.NewRat(5, 100),
+		auto.CoverageDeductibleTypeIncreased: big.NewRat(10, 100),
+	}
+	deductibleFactors = map[auto.CoverageDeductibleType]*big.Rat{
+		auto.CoverageDeductibleTypeReduced:   big.NewRat(115, 100),
+		auto.CoverageDeductibleTypeNormal:    big.NewRat(1, 1),
+		auto.CoverageDeductibleTypeIncreased: big.NewRat(85, 100),
+	}
+
+	// minimumNetAmount is the net premium charged when the quote has no coverages.
+	minimumNetAmount = big.NewRat(100, 1)
+)
+
+// offerVariants define how the offers of a quote differ from each other.
+// When more offers are requested than variants exist, they are reused.
+var offerVariants = []struct {
+	deductibleType   auto.CoverageDeductibleType
+	paymentsQuantity int
+	paymentType      insurer.PaymentType
+}{
+	{auto.CoverageDeductibleTypeNormal, 1, insurer.PaymentTypePix},
+	{auto.CoverageDeductibleTypeReduced, 4, insurer.PaymentTypeBankSlip},
+	{auto.CoverageDeductibleTypeIncreased, 10, insurer.PaymentTypeCreditCard},
+}
+
+// price computes an offer for the quote with the variant at index i.
+func (q *Quote) price(i int) Offer {
+	variant := offerVariants[i%len(offerVariants)]
+	factor := new(big.Rat).Mul(q.vehicleFactor(), q.customerFactor())
+	factor.Mul(factor, q.termFactor())
+
+	offer := Offer{
+		InsurerQuoteID:      uuid.New().String(),
+		SusepProcessNumbers: []string{strutil.Random(50)},
+		Coverages:           []OfferCoverage{},
+		Assistances:         []Assistance{},
+		Premium: Premium{
+			PaymentsQuantity: strconv.Itoa(variant.paymentsQuantity),
+			Coverages:        []auto.PremiumCoverage{},
+		},
+	}
+
+	netAmount := new(big.Rat)
+	if q.Data.Coverages != nil {
+		for _, c := range *q.Data.Coverages {
+			lmi, err := c.MaxLMI.Value()
+			if err != nil {
+				lmi = new(big.Rat)
+			}
+
+			rate, ok := coverageRates[c.Code]
+			if !ok {
+				rate = defaultCoverageRate
+			}
+
+			premium := new(big.Rat).Mul(lmi, rate)
+			premium.Mul(premium, factor)
+
+			offerCoverage := OfferCoverage{
+				Branch:                       c.Branch,
+				Code:                         c.Code,
+				Description:                  c.Description,
+				InternalCode:                 c.InternalCode,
+				IsSeparateContractingAllowed: c.IsSeparateContractingAllowed,
+				POS: auto.CoveragePOS{
+					ApplicationType: insurer.ValueTypeValue,
+					MaxValue:        &c.MaxLMI,
+				},
+				FullIndemnity: insurer.ValueTypeValue,
+			}
+			if isHullCoverage(c.Code) {
+				premium.Mul(premium, deductibleFactors[variant.deductibleType])
+				deductibleAmount := insurer.NewAmount(new(big.Rat).Mul(lmi, deductibleRates[variant.deductibleType]))
+				offerCoverage.Deductible = &auto.CoverageDeductible{
+					Type:   variant.deductibleType,
+					Amount: &deductibleAmount,
+				}
+			}
+			// Round each coverage premium so the net amount is exactly the sum
+			// of the coverage premiums.
+			premium = insurer.Round(premium)
+			netAmount.Add(netAmount, premium)
+
+			offer.Coverages = append(offer.Coverages, offerCoverage)
+			offer.Premium.Coverages = append(offer.Premium.Coverages, auto.PremiumCoverage{
+				Branch:        c.Branch,
+				Code:          c.Code,
+				Description:   c.Description,
+				PremiumAmount: insurer.NewAmount(premium),
+			})
+		}
+	}
+
+	if netAmount.Sign() == 0 {
+		netAmount = minimumNetAmount
+	}
+
+	iof := insurer.Round(new(big.Rat).Mul(netAmount, insurer.IOFRate))
+	totalAmount := new(big.Rat).Add(netAmount, iof)
+
+	offer.Premium.TotalNetAmount = insurer.NewAmount(netAmount)
+	offer.Premium.IOF = insurer.NewAmount(iof)
+	offer.Premium.TotalAmount = insurer.NewAmount(totalAmount)
+	var interestRate float32
+	offer.Premium.InterestRateOverPayments = &interestRate
+	offer.Premium.Payments = q.payments(totalAmount, variant.paymentsQuantity, variant.paymentType)
+	return offer
+}
+
+// payments splits the total amount into installments. The first installment
+// absorbs the rounding difference so the installments add up to the total.
+func (q *Quote) payments(totalAmount *big.Rat, quantity int, paymentType insurer.PaymentType) []auto.Payment {
+	installment := new(big.Rat).Quo(totalAmount, big.NewRat(int64(quantity), 1))
+	installment = insurer.Round(installment)
+	first := new(big.Rat).Sub(totalAmount, new(big.Rat).Mul(installment, big.NewRat(int64(quantity-1), 1)))
+
+	payments := make([]auto.Payment, 0, quantity)
+	for i := range quantity {
+		amount := installment
+		if i == 0 {
+			amount = first
+		}
+		payments = append(payments, auto.Payment{
+			MovementDate:           q.Data.TermStartDate,
+			MovementType:           insurer.PaymentMovementTypePremiumLiquidation,
+			MovementPaymentsNumber: i + 1,
+			Amount:                 insurer.NewAmount(amount),
+			MaturityDate:           q.Data.TermStartDate.AddDate(0, i, 0),
+			PaymentType:            &paymentType,
+		})
+	}
+	return payments
+}
+
+// vehicleFactor adjusts the premium based on the characteristics of the
+// insured vehicle.
+func (q *Quote) vehicleFactor() *big.Rat {
+	factor := big.NewRat(1, 1)
+	obj := q.Data.InsuredObject
+	if obj == nil {
+		return factor
+	}
+
+	if obj.IsArmouredVehicle {
+		factor.Mul(factor, big.NewRat(120, 100))
+	}
+	if obj.IsGasKit {
+		factor.Mul(factor, big.NewRat(110, 100))
+	}
+	if obj.IsBrandNew {
+		factor.Mul(factor, big.NewRat(95, 100))
+	}
+	if obj.Model != nil && obj.Model.ManufactureYear != nil {
+		if year, err := strconv.Atoi(*obj.Model.ManufactureYear); err == nil && timeutil.Now().Year()-year > 10 {
+			factor.Mul(factor, big.NewRat(110, 100))
+		}
+	}
+	if obj.WasThereAClaim != nil && *obj.WasThereAClaim {
+		factor.Mul(factor, big.NewRat(120, 100))
+	}
+	return factor
+}
+
+// customerFactor adjusts the premium based on the customer profile, i.e. the
+// customer age and bonus class.
+func (q *Quote) customerFactor() *big.Rat {
+	factor := big.NewRat(1, 1)
+
+	if personal := q.Data.Customer.Personal; personal != nil && personal.Identification != nil && personal.Identification.BirthDate != nil {
+		age := ageAt(*personal.Identification.BirthDate, q.Data.TermStartDate)
+		switch {
+		case age < 25:
+			factor.Mul(factor, big.NewRat(130, 100))
+		case age >= 60:
+			factor.Mul(factor, big.NewRat(110, 100))
+		}
+	}
+
+	if q.Data.Customer.Business != nil {
+		factor.Mul(factor, big.NewRat(110, 100))
+	}
+
+	// Each bonus class grants a 5% discount, up to 50%.
+	if q.Data.BonusClass != nil {
+		if class, err := strconv.Atoi(*q.Data.BonusClass); err == nil && class > 0 {
+			class = min(class, 10)
+			factor.Mul(factor, big.NewRat(int64(100-5*class), 100))
+		}
+	}
+	return factor
+}
+
+// termFactor prorates the annual premium by the number of days in the term.
+func (q *Quote) termFactor() *big.Rat {
+	days := int64(q.Data.TermEndDate.Sub(q.Data.TermStartDate.Time) / (24 * time.Hour))
+	if days < 1 {
+		days = 1
+	}
+	return big.NewRat(days, 365)
+}
+
+func isHullCoverage(code auto.CoverageCode) bool {
+	switch code {
+	case auto.CoverageCodeComprehensive,
+		auto.CoverageCodeFireTheftBurglary,
+		auto.CoverageCodeTheftBurglary,
+		auto.CoverageCodeFire,
+		auto.CoverageCodeFlood,
+		auto.CoverageCodeCollisionPartial,
+		auto.CoverageCodeCollisionFull:
+		return true
+	default:
+		return false
+	}
+}
+
+func ageAt(birthDate, date timeutil.BrazilDate) int {
+	age := date.Year() - birthDate.Year()
+	if date.Month() < birthDate.Month() || (date.Month() == birthDate.Month() && date.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
@@ -0,0 +1,93 @@
+package auto
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/insurer"
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+func TestQuote_CreateOffers(t *testing.T) {
+	// Given.
+	termStart := timeutil.NewBrazilDate(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	q := &Quote{
+		Data: Data{
+			TermStartDate: termStart,
+			TermEndDate:   termStart.AddDate(1, 0, 0),
+			Coverages: &[]Coverage{
+				{
+					Branch: "0531",
+					Code:   auto.CoverageCodeComprehensive,
+					MaxLMI: insurer.NewAmount(big.NewRat(50000, 1)),
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name               string
+		wantNetAmount      string
+		wantIOF            string
+		wantTotalAmount    string
+		wantPayments       []string
+		wantDeductibleType auto.CoverageDeductibleType
+	}{
+		{
+			name:               "should price offer with normal deductible and single payment",
+			wantNetAmount:      "2000.00",
+			wantIOF:            "147.60",
+			wantTotalAmount:    "2147.60",
+			wantPayments:       []string{"2147.60"},
+			wantDeductibleType: auto.CoverageDeductibleTypeNormal,
+		},
+		{
+			name:               "should price offer with reduced deductible and installments",
+			wantNetAmount:      "2300.00",
+			wantIOF:            "169.74",
+			wantTotalAmount:    "2469.74",
+			wantPayments:       []string{"617.42", "617.44", "617.44", "617.44"},
+			wantDeductibleType: auto.CoverageDeductibleTypeReduced,
+		},
+	}
+
+	// When.
+	q.CreateOffers(len(tests))
+
+	// Then.
+	offers := *q.Data.Quotes
+	if len(offers) != len(tests) {
+		t.Fatalf("got %d offers, want %d", len(offers), len(tests))
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			premium := offers[i].Premium
+			if premium.TotalNetAmount.Amount != tt.wantNetAmount {
+				t.Errorf("got net amount %s, want %s", premium.TotalNetAmount.Amount, tt.wantNetAmount)
+			}
+			if premium.IOF.Amount != tt.wantIOF {
+				t.Errorf("got IOF %s, want %s", premium.IOF.Amount, tt.wantIOF)
+			}
+			if premium.TotalAmount.Amount != tt.wantTotalAmount {
+				t.Errorf("got total amount %s, want %s", premium.TotalAmount.Amount, tt.wantTotalAmount)
+			}
+			if len(premium.Coverages) != 1 || premium.Coverages[0].PremiumAmount.Amount != tt.wantNetAmount {
+				t.Errorf("got coverage premiums %v, want a single coverage premium of %s", premium.Coverages, tt.wantNetAmount)
+			}
+			if len(premium.Payments) != len(tt.wantPayments) {
+				t.Fatalf("got %d payments, want %d", len(premium.Payments), len(tt.wantPayments))
+			}
+			for j, p := range premium.Payments {
+				if p.Amount.Amount != tt.wantPayments[j] {
+					t.Errorf("got payment %d amount %s, want %s", j, p.Amount.Amount, tt.wantPayments[j])
+				}
+			}
+			if d := offers[i].Coverages[0].Deductible; d == nil || d.Type != tt.wantDeductibleType {
+				t.Errorf("got deductible %v, want type %s", d, tt.wantDeductibleType)
+			}
+		})
+	}
+}
@@ -3,19 +3,31 @@ package auto
 import (
 	"context"
 
+	"github.com/google/uuid"
+	"github.com/luikyv/mock-insurer/internal/auto"
+	"github.com/luikyv/mock-insurer/internal/dynamicfield"
+	"github.com/luikyv/mock-insurer/internal/page"
 	"github.com/luikyv/mock-insurer/internal/quote"
 	"gorm.io/gorm"
 )
 
 type Service struct {
-	serviceLead quote.ServiceLead[*Lead]
-	service     quote.Service[*Quote]
+	db                  *gorm.DB
+	contractURL         string
+	serviceLead         quote.ServiceLead[*Lead]
+	service             quote.Service[*Quote]
+	autoService         auto.Service
+	dynamicFieldService dynamicfield.Service
 }
 
-func NewService(db *gorm.DB) Service {
+func NewService(db *gorm.DB, contractURL string, autoService auto.Service, dynamicFieldService dynamicfield.Service) Service {
 	return Service{
-		serviceLead: quote.NewServiceLead[*Lead](db),
-		service:     quote.NewService[*Quote](db),
+		db:                  db,
+		contractURL:         contractURL,
+		serviceLead:         quote.NewServiceLead[*Lead](db),
+		service:             quote.NewService[*Quote](db, contractURL),
+		autoService:         autoService,
+		dynamicFieldService: dynamicFieldService,
 	}
 }
 
@@ -27,10 +39,33 @@ func (s Service) CancelLead(ctx context.Context, consentID, orgID string, data q
 	return s.serviceLead.CancelLead(ctx, consentID, orgID, data)
 }
 
+func (s Service) LeadByID(ctx context.Context, id, orgID string) (*Lead, error) {
+	return s.serviceLead.LeadByID(ctx, id, orgID)
+}
+
+func (s Service) Leads(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[*Lead], error) {
+	return s.serviceLead.Leads(ctx, filter, orgID, pag)
+}
+
+func (s Service) LeadStatusHistory(ctx context.Context, id, orgID string) ([]*quote.StatusHistory, error) {
+	return s.serviceLead.StatusHistory(ctx, id, orgID)
+}
+
+func (s Service) ForceLeadStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (*Lead, error) {
+	return s.serviceLead.ForceStatus(ctx, id, orgID, status, reason)
+}
+
 func (s Service) CreateQuote(ctx context.Context, q *Quote) error {
+	if err := s.dynamicFieldService.Validate(ctx, API, q.Data.CustomData, q.OrgID); err != nil {
+		return err
+	}
 	return s.service.CreateQuote(ctx, q)
 }
 
+func (s Service) ResumeAutomations(ctx context.Context) error {
+	return s.service.ResumeAutomations(ctx)
+}
+
 func (s Service) Quote(ctx context.Context, consentID, orgID string) (*Quote, error) {
 	return s.service.Quote(ctx, consentID, orgID)
 }
@@ -38,3 +73,48 @@ func (s Service) Quote(ctx context.Context, consentID, orgID string) (*Quote, er
 func (s Service) Update(ctx context.Context, consentID, orgID string, patchData quote.PatchData) (*Quote, error) {
 	return s.service.Update(ctx, consentID, orgID, patchData)
 }
+
+func (s Service) QuoteByID(ctx context.Context, id, orgID string) (*Quote, error) {
+	return s.service.QuoteByID(ctx, id, orgID)
+}
+
+func (s Service) Quotes(ctx context.Context, filter quote.Filter, orgID string, pag page.Pagination) (page.Page[*Quote], error) {
+	return s.service.Quotes(ctx, filter, orgID, pag)
+}
+
+func (s Service) StatusHistory(ctx context.Context, id, orgID string) ([]*quote.StatusHistory, error) {
+	return s.service.StatusHistory(ctx, id, orgID)
+}
+
+func (s Service) ForceStatus(ctx context.Context, id, orgID string, status quote.Status, reason string) (*Quote, error) {
+	return s.service.ForceStatus(ctx, id, orgID, status, reason)
+}
+
+// Contract issues the auto policy for the offer chosen in the quote. The
+// policy belongs to ownerID and is available through the auto insurance API.
+// The policy is created in the same transaction that records it in the quote.
+func (s Service) Contract(ctx context.Context, id, orgID string, ownerID uuid.UUID) (*Quote, error) {
+	var q *Quote
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		txService := quote.NewService[*Quote](tx, s.contractURL)
+		txAutoService := auto.NewService(tx)
+
+		var err error
+		q, err = txService.Contract(ctx, id, orgID, func(q *Quote) (string, error) {
+			policy, err := q.Policy(ownerID)
+			if err != nil {
+				return "", err
+			}
+
+			if err := txAutoService.CreatePolicy(ctx, policy); err != nil {
+				return "", err
+			}
+			return policy.ID, nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
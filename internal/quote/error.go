@@ -2,4 +2,9 @@ package quote
 
 import "errors"
 
-var ErrNotFound = errors.New("quote not found")
+var (
+	ErrNotFound          = errors.New("quote not found")
+	ErrExpired           = errors.New("quote expired")
+	ErrAlreadyContracted = errors.New("quote already contracted")
+	ErrScenarioNotFound  = errors.New("quote scenario not found")
+)
@@ -1,10 +1,15 @@
 package quote
 
 import (
+	"fmt"
+	"math/big"
+	"slices"
+
 	"github.com/google/uuid"
 	"github.com/luikyv/mock-insurer/internal/customer"
 	"github.com/luikyv/mock-insurer/internal/insurer"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"gorm.io/gorm"
 )
 
 type Lead interface {
@@ -26,6 +31,7 @@ type Quote interface {
 	SetCreatedAt(timeutil.DateTime)
 	GetTermStartDate() timeutil.BrazilDate
 	GetTermEndDate() timeutil.BrazilDate
+	GetExpirationDateTime() timeutil.DateTime
 	SetRejectionReason(string)
 	SetInsurerQuoteID(string)
 	SetProtocolDateTime(timeutil.DateTime)
@@ -33,8 +39,18 @@ type Quote interface {
 	SetRedirectLink(string)
 	GetPersonalIdentification() *string
 	GetBusinessIdentification() *string
+	// GetLicensePlate returns the plate of the insured vehicle, if any.
+	GetLicensePlate() *string
+	// GetAmount returns the total amount requested for the quote, e.g. the sum
+	// of the max LMI of all coverages.
+	GetAmount() string
+	GetCustomData() *CustomData
 	GetOfferIDs() []string
-	CreateOffers()
+	CreateOffers(n int)
+	// GetIssuedPolicyID returns the ID of the policy issued when the quote was
+	// contracted, if any.
+	GetIssuedPolicyID() *string
+	SetIssuedPolicyID(string)
 	GetOrgID() string
 }
 
@@ -49,6 +65,23 @@ const (
 	StatusCancelled    Status = "CANC"
 )
 
+var statuses = []Status{
+	StatusReceived,
+	StatusEvaluated,
+	StatusAccepted,
+	StatusRejected,
+	StatusAcknowledged,
+	StatusCancelled,
+}
+
+// ForcedRejectionReason is the rejection reason of quotes moved to RJCT
+// through the admin API without a reason.
+const ForcedRejectionReason = "quote rejected by the insurer"
+
+// ExpirationReason is the rejection reason of quotes that expired before
+// being concluded.
+const ExpirationReason = "quote expired before being acknowledged"
+
 type Customer struct {
 	Personal *PersonalData `json:"personal,omitempty"`
 	Business *BusinessData `json:"business,omitempty"`
@@ -78,6 +111,31 @@ type CustomData struct {
 	GeneralClaimInfo          *[]CustomDataField `json:"generalClaimInfo,omitempty"`
 }
 
+// Fields returns the custom fields of all groups.
+func (d *CustomData) Fields() []CustomDataField {
+	if d == nil {
+		return nil
+	}
+
+	var fields []CustomDataField
+	for _, group := range []*[]CustomDataField{
+		d.CustomerIdentification,
+		d.CustomerQualification,
+		d.CustomerComplimentaryInfo,
+		d.GeneralQuoteInfo,
+		d.RiskLocationInfo,
+		d.InsuredObjects,
+		d.Beneficiaries,
+		d.Coverages,
+		d.GeneralClaimInfo,
+	} {
+		if group != nil {
+			fields = append(fields, *group...)
+		}
+	}
+	return fields
+}
+
 type CustomDataField struct {
 	FieldID string `json:"fieldId"`
 	Value   any    `json:"value"`
@@ -98,4 +156,149 @@ type LeadQuery struct {
 type Query struct {
 	ID        string
 	ConsentID string
+	// ForUpdate locks the record until the end of the current transaction.
+	ForUpdate bool
+}
+
+// Filter restricts the leads and quotes listed. Empty fields are ignored.
+type Filter struct {
+	Status Status
+	// From and To limit the creation date time of the records.
+	From *timeutil.DateTime
+	To   *timeutil.DateTime
+	// Document is the CPF or CNPJ of the customer.
+	Document string
+}
+
+// StatusHistory records a status transition of a lead or quote.
+type StatusHistory struct {
+	ID uuid.UUID `gorm:"primaryKey"`
+	// QuoteID is the ID of the lead or quote.
+	QuoteID uuid.UUID
+	Status  Status
+	Reason  *string
+	// Forced indicates the transition was imposed through the admin API
+	// instead of following the quote flow.
+	Forced    bool
+	OrgID     string
+	CreatedAt timeutil.DateTime
+}
+
+func (StatusHistory) TableName() string {
+	return "quote_status_history"
+}
+
+func (h *StatusHistory) BeforeCreate(tx *gorm.DB) error {
+	if h.ID == uuid.Nil {
+		h.ID = uuid.New()
+	}
+	return nil
+}
+
+// Scenario defines how quotes that match its rule are evaluated.
+// Scenarios are evaluated by ascending priority and the first match wins.
+type Scenario struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	Name      string
+	Priority  int
+	Rule      ScenarioRule    `gorm:"serializer:json"`
+	Outcome   ScenarioOutcome `gorm:"serializer:json"`
+	OrgID     string
+	CreatedAt timeutil.DateTime
+	UpdatedAt timeutil.DateTime
+}
+
+func (Scenario) TableName() string {
+	return "quote_scenarios"
+}
+
+func (s *Scenario) BeforeCreate(tx *gorm.DB) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+	return nil
+}
+
+// ScenarioRule holds the conditions a quote must satisfy for the scenario to
+// be applied. Conditions left empty are ignored, so an empty rule matches
+// every quote.
+type ScenarioRule struct {
+	CPF          *string          `json:"cpf,omitempty"`
+	CNPJ         *string          `json:"cnpj,omitempty"`
+	LicensePlate *string          `json:"licensePlate,omitempty"`
+	MinAmount    *string          `json:"minAmount,omitempty"`
+	MaxAmount    *string          `json:"maxAmount,omitempty"`
+	CustomField  *CustomDataField `json:"customField,omitempty"`
+}
+
+func (r ScenarioRule) Matches(q Quote) bool {
+	if r.CPF != nil && !equalPtr(q.GetPersonalIdentification(), *r.CPF) {
+		return false
+	}
+
+	if r.CNPJ != nil && !equalPtr(q.GetBusinessIdentification(), *r.CNPJ) {
+		return false
+	}
+
+	if r.LicensePlate != nil && !equalPtr(q.GetLicensePlate(), *r.LicensePlate) {
+		return false
+	}
+
+	if r.MinAmount != nil || r.MaxAmount != nil {
+		amount, ok := new(big.Rat).SetString(q.GetAmount())
+		if !ok {
+			return false
+		}
+		if r.MinAmount != nil {
+			minAmount, ok := new(big.Rat).SetString(*r.MinAmount)
+			if !ok || amount.Cmp(minAmount) < 0 {
+				return false
+			}
+		}
+		if r.MaxAmount != nil {
+			maxAmount, ok := new(big.Rat).SetString(*r.MaxAmount)
+			if !ok || amount.Cmp(maxAmount) > 0 {
+				return false
+			}
+		}
+	}
+
+	if r.CustomField != nil && !slices.ContainsFunc(q.GetCustomData().Fields(), func(f CustomDataField) bool {
+		return f.FieldID == r.CustomField.FieldID && fmt.Sprint(f.Value) == fmt.Sprint(r.CustomField.Value)
+	}) {
+		return false
+	}
+
+	return true
+}
+
+// ScenarioOutcome is the result of evaluating a quote that matched a scenario.
+// Delays are in seconds.
+type ScenarioOutcome struct {
+	// Status is the final status of the quote. When set to EVAL, the quote
+	// stays under evaluation indefinitely.
+	Status          Status `json:"status"`
+	RejectionReason string `json:"rejectionReason,omitempty"`
+	// Offers is the number of offers created when the quote is accepted.
+	Offers int `json:"offers,omitempty"`
+	// EvaluationDelay is the time taken to move the quote from RCVD to EVAL.
+	EvaluationDelay int `json:"evaluationDelay"`
+	// DecisionDelay is the time taken to move the quote from EVAL to its final status.
+	DecisionDelay int `json:"decisionDelay"`
+}
+
+const (
+	maxScenarioOffers = 10
+	maxScenarioDelay  = 3600
+)
+
+var DefaultScenarioOutcome = ScenarioOutcome{
+	Status:          StatusAccepted,
+	Offers:          1,
+	EvaluationDelay: 15,
+	DecisionDelay:   15,
+}
+
+func equalPtr(p *string, v string) bool {
+	return p != nil && *p == v
 }
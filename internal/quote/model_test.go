@@ -0,0 +1,104 @@
+package quote_test
+
+import (
+	"testing"
+
+	"github.com/luikyv/mock-insurer/internal/customer"
+	"github.com/luikyv/mock-insurer/internal/insurer"
+	"github.com/luikyv/mock-insurer/internal/quote"
+	quoteauto "github.com/luikyv/mock-insurer/internal/quote/auto"
+)
+
+func TestScenarioRule_Matches(t *testing.T) {
+	// Given.
+	q := &quoteauto.Quote{
+		Data: quoteauto.Data{
+			Customer: quote.Customer{
+				Personal: &quote.PersonalData{
+					Identification: &customer.PersonalIdentificationData{CPF: "76109277673"},
+				},
+			},
+			InsuredObject: &quoteauto.InsuredObject{LicensePlate: pointerOf("ABC1D23")},
+			Coverages: &[]quoteauto.Coverage{
+				{MaxLMI: insurer.AmountDetails{Amount: "1000.00"}},
+				{MaxLMI: insurer.AmountDetails{Amount: "500.50"}},
+			},
+			CustomData: &quote.CustomData{
+				GeneralQuoteInfo: &[]quote.CustomDataField{{FieldID: "scenario", Value: "reject"}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name string
+		rule quote.ScenarioRule
+		want bool
+	}{
+		{
+			name: "should match empty rule",
+			rule: quote.ScenarioRule{},
+			want: true,
+		},
+		{
+			name: "should match cpf",
+			rule: quote.ScenarioRule{CPF: pointerOf("76109277673")},
+			want: true,
+		},
+		{
+			name: "should not match different cpf",
+			rule: quote.ScenarioRule{CPF: pointerOf("00000000000")},
+			want: false,
+		},
+		{
+			name: "should not match cnpj when customer is personal",
+			rule: quote.ScenarioRule{CNPJ: pointerOf("50685362000673")},
+			want: false,
+		},
+		{
+			name: "should match license plate",
+			rule: quote.ScenarioRule{LicensePlate: pointerOf("ABC1D23")},
+			want: true,
+		},
+		{
+			name: "should match amount within range",
+			rule: quote.ScenarioRule{MinAmount: pointerOf("1500.50"), MaxAmount: pointerOf("2000")},
+			want: true,
+		},
+		{
+			name: "should not match amount above max",
+			rule: quote.ScenarioRule{MaxAmount: pointerOf("1500.49")},
+			want: false,
+		},
+		{
+			name: "should match custom field",
+			rule: quote.ScenarioRule{CustomField: &quote.CustomDataField{FieldID: "scenario", Value: "reject"}},
+			want: true,
+		},
+		{
+			name: "should not match custom field with different value",
+			rule: quote.ScenarioRule{CustomField: &quote.CustomDataField{FieldID: "scenario", Value: "accept"}},
+			want: false,
+		},
+		{
+			name: "should require all conditions to match",
+			rule: quote.ScenarioRule{CPF: pointerOf("76109277673"), LicensePlate: pointerOf("XYZ9A87")},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			got := tt.rule.Matches(q)
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func pointerOf[T any](v T) *T {
+	return &v
+}
@@ -2,23 +2,86 @@ package quote
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"math/big"
 	"slices"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/luikyv/mock-insurer/internal/errorutil"
 	"github.com/luikyv/mock-insurer/internal/insurer"
+	"github.com/luikyv/mock-insurer/internal/page"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
 	"gorm.io/gorm"
 )
 
+var (
+	// pendingAutomations counts the quotes whose automations are still running.
+	pendingAutomations atomic.Int64
+	// automations waits for the running automations when they're stopped.
+	automations sync.WaitGroup
+	// automationsStopped is guarded by automationsMu so no automation starts
+	// while StopAutomations waits.
+	automationsStopped bool
+	automationsMu      sync.Mutex
+)
+
+// PendingAutomations returns the number of quotes waiting for their automated
+// status transitions.
+func PendingAutomations() int64 {
+	return pendingAutomations.Load()
+}
+
+// StopAutomations prevents new automations from starting and waits for the
+// running ones to finish. Quotes whose automations don't finish before ctx is
+// done keep their status and are resumed by ResumeAutomations on the next
+// start.
+func StopAutomations(ctx context.Context) error {
+	automationsMu.Lock()
+	automationsStopped = true
+	automationsMu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		automations.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop quote automations: %w", ctx.Err())
+	}
+}
+
+// startAutomation registers a new automation unless the automations are
+// stopped.
+func startAutomation() bool {
+	automationsMu.Lock()
+	defer automationsMu.Unlock()
+
+	if automationsStopped {
+		return false
+	}
+	pendingAutomations.Add(1)
+	automations.Add(1)
+	return true
+}
+
 type ServiceLead[L Lead] struct {
-	storage StorageLead[L]
+	storage        StorageLead[L]
+	historyStorage StatusHistoryStorage
 }
 
 func NewServiceLead[L Lead](db *gorm.DB) ServiceLead[L] {
-	return ServiceLead[L]{storage: storageLead[L]{db: db}}
+	return ServiceLead[L]{
+		storage:        storageLead[L]{db: db},
+		historyStorage: statusHistoryStorage{db: db},
+	}
 }
 
 func (s ServiceLead[L]) CreateLead(ctx context.Context, lead L) error {
@@ -26,7 +89,10 @@ func (s ServiceLead[L]) CreateLead(ctx context.Context, lead L) error {
 	lead.SetStatusUpdatedAt(timeutil.DateTimeNow())
 	lead.SetCreatedAt(timeutil.DateTimeNow())
 	lead.SetUpdatedAt(timeutil.DateTimeNow())
-	return s.storage.create(ctx, lead)
+	if err := s.storage.create(ctx, lead); err != nil {
+		return err
+	}
+	return recordStatus(ctx, s.historyStorage, lead.GetID(), lead.GetOrgID(), StatusReceived, "", false)
 }
 
 func (s ServiceLead[L]) CancelLead(ctx context.Context, consentID, orgID string, data PatchData) (L, error) {
@@ -42,18 +108,66 @@ func (s ServiceLead[L]) lead(ctx context.Context, consentID, orgID string) (L, e
 	return s.storage.lead(ctx, LeadQuery{ConsentID: consentID}, orgID)
 }
 
+func (s ServiceLead[L]) LeadByID(ctx context.Context, id, orgID string) (L, error) {
+	return s.storage.lead(ctx, LeadQuery{ID: id}, orgID)
+}
+
+func (s ServiceLead[L]) Leads(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[L], error) {
+	return s.storage.leads(ctx, filter, orgID, pag)
+}
+
+func (s ServiceLead[L]) StatusHistory(ctx context.Context, id, orgID string) ([]*StatusHistory, error) {
+	return s.historyStorage.history(ctx, id, orgID)
+}
+
+// ForceStatus moves the lead to status regardless of its current one. It is
+// meant for setting up tests.
+func (s ServiceLead[L]) ForceStatus(ctx context.Context, id, orgID string, status Status, reason string) (L, error) {
+	var zero L
+	if !slices.Contains(statuses, status) {
+		return zero, errorutil.Format("invalid status %s", status)
+	}
+
+	lead, err := s.LeadByID(ctx, id, orgID)
+	if err != nil {
+		return zero, err
+	}
+
+	lead.SetStatus(status)
+	lead.SetStatusUpdatedAt(timeutil.DateTimeNow())
+	lead.SetUpdatedAt(timeutil.DateTimeNow())
+	if err := s.storage.update(ctx, lead); err != nil {
+		return zero, err
+	}
+	return lead, recordStatus(ctx, s.historyStorage, lead.GetID(), orgID, status, reason, true)
+}
+
 func (s ServiceLead[L]) updateLeadWithStatus(ctx context.Context, lead L, status Status) error {
 	lead.SetStatus(status)
 	lead.SetStatusUpdatedAt(timeutil.DateTimeNow())
-	return s.storage.update(ctx, lead)
+	lead.SetUpdatedAt(timeutil.DateTimeNow())
+	if err := s.storage.update(ctx, lead); err != nil {
+		return err
+	}
+	return recordStatus(ctx, s.historyStorage, lead.GetID(), lead.GetOrgID(), status, "", false)
 }
 
 type Service[Q Quote] struct {
-	storage Storage[Q]
+	storage         Storage[Q]
+	historyStorage  StatusHistoryStorage
+	scenarioService ScenarioService
+	// contractURL is the base URL of the page where the customer confirms the
+	// purchase of an acknowledged quote.
+	contractURL string
 }
 
-func NewService[Q Quote](db *gorm.DB) Service[Q] {
-	return Service[Q]{storage: storage[Q]{db: db}}
+func NewService[Q Quote](db *gorm.DB, contractURL string) Service[Q] {
+	return Service[Q]{
+		storage:         storage[Q]{db: db},
+		historyStorage:  statusHistoryStorage{db: db},
+		scenarioService: NewScenarioService(db),
+		contractURL:     contractURL,
+	}
 }
 
 func (s Service[Q]) CreateQuote(ctx context.Context, q Q) error {
@@ -65,48 +179,131 @@ func (s Service[Q]) CreateQuote(ctx context.Context, q Q) error {
 		return err
 	}
 
+	if err := recordStatus(ctx, s.historyStorage, q.GetID(), q.GetOrgID(), StatusReceived, "", false); err != nil {
+		return err
+	}
+
+	s.automate(ctx, q)
+	return nil
+}
+
+// resumeBatchSize is the number of quotes loaded at a time when resuming the
+// automations.
+const resumeBatchSize = 100
+
+// ResumeAutomations restarts the automations of the quotes left received or
+// evaluated, e.g. by a previous process that stopped before they finished.
+// The quotes are loaded in batches so a large backlog isn't held in memory at
+// once.
+func (s Service[Q]) ResumeAutomations(ctx context.Context) error {
+	resumed := 0
+	afterID := ""
+	for {
+		quotes, err := s.storage.quotesWithStatus(ctx, afterID, resumeBatchSize, StatusReceived, StatusEvaluated)
+		if err != nil {
+			return err
+		}
+
+		for _, q := range quotes {
+			s.automate(ctx, q)
+		}
+		resumed += len(quotes)
+
+		if len(quotes) < resumeBatchSize {
+			break
+		}
+		afterID = quotes[len(quotes)-1].GetID().String()
+	}
+	slog.InfoContext(ctx, "quote automations resumed", "quotes", resumed)
+	return nil
+}
+
+// automate runs the automations of the quote in the background.
+func (s Service[Q]) automate(ctx context.Context, q Q) {
+	if !startAutomation() {
+		slog.InfoContext(ctx, "quote automations are stopped, the quote will be resumed on the next start", "quote_id", q.GetID())
+		return
+	}
+
 	go func() {
-		run := func(ctx context.Context, q Q) error {
-			switch q.GetStatus() {
-			case StatusReceived:
-				if q.GetTermStartDate().After(q.GetTermEndDate()) {
-					return s.rejectQuote(ctx, q, "term start date is after term end date")
-				}
-				return s.updateQuoteWithStatus(ctx, q, StatusEvaluated)
-			case StatusEvaluated:
-				q.CreateOffers()
-				return s.updateQuoteWithStatus(ctx, q, StatusAccepted)
+		defer automations.Done()
+		defer pendingAutomations.Add(-1)
+		s.evaluate(context.WithoutCancel(ctx), q)
+	}()
+}
+
+// evaluate moves the quote from RCVD to EVAL and then to the final status
+// defined by the scenario it matches, waiting the configured delay before
+// each transition.
+func (s Service[Q]) evaluate(ctx context.Context, q Q) {
+	outcome := s.scenarioService.outcome(ctx, q)
+	slog.DebugContext(ctx, "evaluating quote automations", "quote_id", q.GetID(), "outcome", outcome)
+
+	run := func(ctx context.Context, q Q) error {
+		if isExpired(q) {
+			return s.expire(ctx, q)
+		}
+
+		switch q.GetStatus() {
+		case StatusReceived:
+			if q.GetTermStartDate().After(q.GetTermEndDate()) {
+				return s.rejectQuote(ctx, q, "term start date is after term end date")
+			}
+			return s.updateQuoteWithStatus(ctx, q, StatusEvaluated, "")
+		case StatusEvaluated:
+			switch outcome.Status {
+			case StatusRejected:
+				return s.rejectQuote(ctx, q, outcome.RejectionReason)
+			case StatusAccepted:
+				q.CreateOffers(outcome.Offers)
+				return s.updateQuoteWithStatus(ctx, q, StatusAccepted, "")
 			default:
 				return nil
 			}
+		default:
+			return nil
 		}
+	}
 
-		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 1*time.Minute)
-		defer cancel()
-
-		ticker := time.NewTicker(15 * time.Second)
-		defer ticker.Stop()
+	timeout := time.Duration(outcome.EvaluationDelay+outcome.DecisionDelay)*time.Second + 1*time.Minute
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
 
-		for {
-			select {
-			case <-ticker.C:
-				slog.DebugContext(ctx, "evaluating quote automations")
-				if err := run(ctx, q); err != nil {
-					slog.ErrorContext(ctx, "error running quote automations for quote", "quote_id", q.GetID(), "error", err)
-					return
-				}
-			case <-ctx.Done():
-				slog.DebugContext(ctx, "quote automation deadline reached, stopping ticker")
+	for _, delay := range []int{outcome.EvaluationDelay, outcome.DecisionDelay} {
+		select {
+		case <-time.After(time.Duration(delay) * time.Second):
+			// Reload the quote as it might have been changed in the meantime,
+			// e.g. cancelled by the client.
+			current, err := s.storage.quote(ctx, Query{ID: q.GetID().String()}, q.GetOrgID())
+			if err != nil {
+				slog.ErrorContext(ctx, "error loading quote for automations", "quote_id", q.GetID(), "error", err)
+				return
+			}
+			if err := run(ctx, current); err != nil {
+				slog.ErrorContext(ctx, "error running quote automations for quote", "quote_id", q.GetID(), "error", err)
 				return
 			}
+		case <-ctx.Done():
+			slog.DebugContext(ctx, "quote automation deadline reached, stopping")
+			return
 		}
-	}()
-
-	return nil
+	}
 }
 
 func (s Service[Q]) Quote(ctx context.Context, consentID, orgID string) (Q, error) {
-	return s.storage.quote(ctx, Query{ConsentID: consentID}, orgID)
+	var zero Q
+	q, err := s.storage.quote(ctx, Query{ConsentID: consentID}, orgID)
+	if err != nil {
+		return zero, err
+	}
+
+	if isExpired(q) {
+		if err := s.expire(ctx, q); err != nil {
+			return zero, err
+		}
+	}
+
+	return q, nil
 }
 
 func (s Service[Q]) Update(ctx context.Context, consentID, orgID string, patchData PatchData) (Q, error) {
@@ -138,6 +335,10 @@ func (s Service[Q]) Update(ctx context.Context, consentID, orgID string, patchDa
 	}
 
 	if patchData.Status == StatusAcknowledged {
+		if isPastExpiration(q) {
+			return zero, ErrExpired
+		}
+
 		if q.GetStatus() != StatusAccepted {
 			return zero, errorutil.New("quote not accepted")
 		}
@@ -150,10 +351,7 @@ func (s Service[Q]) Update(ctx context.Context, consentID, orgID string, patchDa
 			return zero, errorutil.New("insurer quote id not found")
 		}
 
-		q.SetInsurerQuoteID(*patchData.InsurerQuoteID)
-		q.SetProtocolDateTime(timeutil.DateTimeNow())
-		q.SetProtocolNumber(uuid.New().String())
-		q.SetRedirectLink("https://www.raidiam.com")
+		s.acknowledge(q, *patchData.InsurerQuoteID)
 	}
 	if patchData.Status == StatusCancelled {
 		if !slices.Contains([]Status{StatusReceived, StatusEvaluated, StatusAccepted}, q.GetStatus()) {
@@ -161,21 +359,237 @@ func (s Service[Q]) Update(ctx context.Context, consentID, orgID string, patchDa
 		}
 	}
 
-	return q, s.updateQuoteWithStatus(ctx, q, patchData.Status)
+	return q, s.updateQuoteWithStatus(ctx, q, patchData.Status, "")
+}
+
+func (s Service[Q]) Quotes(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[Q], error) {
+	return s.storage.quotes(ctx, filter, orgID, pag)
+}
+
+func (s Service[Q]) StatusHistory(ctx context.Context, id, orgID string) ([]*StatusHistory, error) {
+	return s.historyStorage.history(ctx, id, orgID)
+}
+
+// ForceStatus moves the quote to status regardless of its current one. It is
+// meant for setting up tests, so the quote is completed with the information
+// its new status requires, e.g. offers for ACPT.
+func (s Service[Q]) ForceStatus(ctx context.Context, id, orgID string, status Status, reason string) (Q, error) {
+	var zero Q
+	if !slices.Contains(statuses, status) {
+		return zero, errorutil.Format("invalid status %s", status)
+	}
+
+	q, err := s.QuoteByID(ctx, id, orgID)
+	if err != nil {
+		return zero, err
+	}
+
+	switch status {
+	case StatusAccepted, StatusAcknowledged:
+		if len(q.GetOfferIDs()) == 0 {
+			q.CreateOffers(1)
+		}
+		if status == StatusAcknowledged {
+			s.acknowledge(q, q.GetOfferIDs()[0])
+		}
+	case StatusRejected:
+		if reason == "" {
+			reason = ForcedRejectionReason
+		}
+		q.SetRejectionReason(reason)
+	}
+
+	q.SetStatus(status)
+	q.SetStatusUpdatedAt(timeutil.DateTimeNow())
+	if err := s.updateQuote(ctx, q); err != nil {
+		return zero, err
+	}
+	return q, recordStatus(ctx, s.historyStorage, q.GetID(), orgID, status, reason, true)
+}
+
+func (s Service[Q]) QuoteByID(ctx context.Context, id, orgID string) (Q, error) {
+	return s.storage.quote(ctx, Query{ID: id}, orgID)
+}
+
+// Contract concludes the purchase of an acknowledged quote. issue is called
+// to create the policy for the chosen offer and must return its ID.
+// The quote is locked until the end of the transaction, so Contract must run
+// in the same transaction as issue to prevent a quote from being contracted
+// twice.
+func (s Service[Q]) Contract(ctx context.Context, id, orgID string, issue func(Q) (string, error)) (Q, error) {
+	var zero Q
+	q, err := s.storage.quote(ctx, Query{ID: id, ForUpdate: true}, orgID)
+	if err != nil {
+		return zero, err
+	}
+
+	if q.GetIssuedPolicyID() != nil {
+		return zero, ErrAlreadyContracted
+	}
+
+	if q.GetStatus() != StatusAcknowledged {
+		return zero, errorutil.New("quote not acknowledged")
+	}
+
+	policyID, err := issue(q)
+	if err != nil {
+		return zero, err
+	}
+
+	q.SetIssuedPolicyID(policyID)
+	return q, s.updateQuote(ctx, q)
+}
+
+// expire rejects the quote, and thus its offers, since it was not concluded
+// before its expiration date time.
+func (s Service[Q]) expire(ctx context.Context, q Q) error {
+	slog.InfoContext(ctx, "quote expired", "quote_id", q.GetID(), "expiration_date_time", q.GetExpirationDateTime())
+	return s.rejectQuote(ctx, q, ExpirationReason)
 }
 
 func (s Service[Q]) rejectQuote(ctx context.Context, q Q, reason string) error {
 	q.SetRejectionReason(reason)
-	return s.updateQuoteWithStatus(ctx, q, StatusRejected)
+	return s.updateQuoteWithStatus(ctx, q, StatusRejected, reason)
+}
+
+// acknowledge sets the offer chosen by the customer and the link to the page
+// where the purchase is concluded.
+func (s Service[Q]) acknowledge(q Q, insurerQuoteID string) {
+	q.SetInsurerQuoteID(insurerQuoteID)
+	q.SetProtocolDateTime(timeutil.DateTimeNow())
+	q.SetProtocolNumber(uuid.New().String())
+	q.SetRedirectLink(fmt.Sprintf("%s/%s/%s", s.contractURL, q.GetOrgID(), q.GetID()))
 }
 
-func (s Service[Q]) updateQuoteWithStatus(ctx context.Context, q Q, status Status) error {
+func (s Service[Q]) updateQuoteWithStatus(ctx context.Context, q Q, status Status, reason string) error {
 	q.SetStatus(status)
 	q.SetStatusUpdatedAt(timeutil.DateTimeNow())
-	return s.updateQuote(ctx, q)
+	if err := s.updateQuote(ctx, q); err != nil {
+		return err
+	}
+	return recordStatus(ctx, s.historyStorage, q.GetID(), q.GetOrgID(), status, reason, false)
 }
 
 func (s Service[Q]) updateQuote(ctx context.Context, q Q) error {
 	q.SetUpdatedAt(timeutil.DateTimeNow())
 	return s.storage.update(ctx, q)
 }
+
+func recordStatus(ctx context.Context, storage StatusHistoryStorage, id uuid.UUID, orgID string, status Status, reason string, forced bool) error {
+	h := &StatusHistory{
+		QuoteID:   id,
+		Status:    status,
+		Forced:    forced,
+		OrgID:     orgID,
+		CreatedAt: timeutil.DateTimeNow(),
+	}
+	if reason != "" {
+		h.Reason = &reason
+	}
+	return storage.create(ctx, h)
+}
+
+type ScenarioService struct {
+	storage ScenarioStorage
+}
+
+func NewScenarioService(db *gorm.DB) ScenarioService {
+	return ScenarioService{storage: scenarioStorage{db: db}}
+}
+
+// Save creates the scenario or replaces the one with the same name.
+func (s ScenarioService) Save(ctx context.Context, scenario *Scenario) error {
+	if err := validateScenario(scenario); err != nil {
+		return err
+	}
+
+	scenario.UpdatedAt = timeutil.DateTimeNow()
+	return s.storage.save(ctx, scenario)
+}
+
+func (s ScenarioService) Scenarios(ctx context.Context, orgID string) ([]*Scenario, error) {
+	return s.storage.scenarios(ctx, orgID)
+}
+
+func (s ScenarioService) Delete(ctx context.Context, name, orgID string) error {
+	return s.storage.delete(ctx, name, orgID)
+}
+
+// outcome returns the outcome of the first scenario matching the quote or
+// the default outcome if none does.
+func (s ScenarioService) outcome(ctx context.Context, q Quote) ScenarioOutcome {
+	scenarios, err := s.storage.scenarios(ctx, q.GetOrgID())
+	if err != nil {
+		slog.ErrorContext(ctx, "could not load quote scenarios, using the default outcome", "error", err)
+		return DefaultScenarioOutcome
+	}
+
+	for _, scenario := range scenarios {
+		if scenario.Rule.Matches(q) {
+			slog.DebugContext(ctx, "quote matched scenario", "quote_id", q.GetID(), "scenario", scenario.Name)
+			return scenario.Outcome
+		}
+	}
+	return DefaultScenarioOutcome
+}
+
+func validateScenario(scenario *Scenario) error {
+	if scenario.Name == "" {
+		return errorutil.New("scenario name is required")
+	}
+
+	for _, amount := range []*string{scenario.Rule.MinAmount, scenario.Rule.MaxAmount} {
+		if amount == nil {
+			continue
+		}
+		if _, ok := new(big.Rat).SetString(*amount); !ok {
+			return errorutil.Format("invalid amount %s", *amount)
+		}
+	}
+
+	if scenario.Rule.CustomField != nil && scenario.Rule.CustomField.FieldID == "" {
+		return errorutil.New("custom field id is required")
+	}
+
+	outcome := scenario.Outcome
+	switch outcome.Status {
+	case StatusAccepted:
+		if outcome.Offers < 1 || outcome.Offers > maxScenarioOffers {
+			return errorutil.Format("the number of offers must be between 1 and %d", maxScenarioOffers)
+		}
+	case StatusRejected:
+		if outcome.RejectionReason == "" {
+			return errorutil.New("rejection reason is required for rejected outcomes")
+		}
+	case StatusEvaluated:
+	default:
+		return errorutil.Format("invalid outcome status %s", outcome.Status)
+	}
+
+	if outcome.EvaluationDelay < 0 || outcome.EvaluationDelay > maxScenarioDelay ||
+		outcome.DecisionDelay < 0 || outcome.DecisionDelay > maxScenarioDelay {
+		return errorutil.Format("delays must be between 0 and %d seconds", maxScenarioDelay)
+	}
+
+	return nil
+}
+
+// isExpired reports whether the quote is still in progress after its
+// expiration date time.
+func isExpired(q Quote) bool {
+	if !slices.Contains([]Status{StatusReceived, StatusEvaluated, StatusAccepted}, q.GetStatus()) {
+		return false
+	}
+
+	return isPastExpiration(q)
+}
+
+// isPastExpiration reports whether the expiration date time of the quote has
+// passed. Quotes without one never expire.
+func isPastExpiration(q Quote) bool {
+	if q.GetExpirationDateTime().IsZero() {
+		return false
+	}
+
+	return timeutil.DateTimeNow().After(q.GetExpirationDateTime())
+}
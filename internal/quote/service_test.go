@@ -0,0 +1,74 @@
+package quote
+
+import (
+	"testing"
+	"time"
+
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+func TestIsExpired(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    Status
+		expiresAt timeutil.DateTime
+		want      bool
+	}{
+		{
+			name:      "should expire a received quote past its expiration",
+			status:    StatusReceived,
+			expiresAt: timeutil.DateTimeNow().Add(-time.Minute),
+			want:      true,
+		},
+		{
+			name:      "should expire an accepted quote past its expiration",
+			status:    StatusAccepted,
+			expiresAt: timeutil.DateTimeNow().Add(-time.Minute),
+			want:      true,
+		},
+		{
+			name:      "should not expire a quote before its expiration",
+			status:    StatusEvaluated,
+			expiresAt: timeutil.DateTimeNow().Add(time.Minute),
+		},
+		{
+			name:   "should not expire a quote without expiration",
+			status: StatusAccepted,
+		},
+		{
+			name:      "should not expire a quote no longer in progress",
+			status:    StatusAcknowledged,
+			expiresAt: timeutil.DateTimeNow().Add(-time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Given.
+			q := testQuote{status: tt.status, expiresAt: tt.expiresAt}
+
+			// When.
+			got := isExpired(q)
+
+			// Then.
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+// testQuote implements only the methods of Quote used by the functions tested.
+type testQuote struct {
+	Quote
+	status    Status
+	expiresAt timeutil.DateTime
+}
+
+func (q testQuote) GetStatus() Status {
+	return q.status
+}
+
+func (q testQuote) GetExpirationDateTime() timeutil.DateTime {
+	return q.expiresAt
+}
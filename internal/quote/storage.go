@@ -5,13 +5,16 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/luikyv/mock-insurer/internal/page"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type StorageLead[L Lead] interface {
 	create(context.Context, L) error
 	update(context.Context, L) error
 	lead(ctx context.Context, query LeadQuery, orgID string) (L, error)
+	leads(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[L], error)
 }
 
 type storageLead[L Lead] struct {
@@ -62,10 +65,22 @@ func (s storageLead[L]) lead(ctx context.Context, opts LeadQuery, orgID string)
 	return *lead, nil
 }
 
+//nolint:unused
+func (s storageLead[L]) leads(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[L], error) {
+	query := s.db.WithContext(ctx).Model(new(L)).Where("org_id = ?", orgID).Order("created_at DESC")
+	leads, err := page.Paginate[L](applyFilter(query, filter), pag)
+	if err != nil {
+		return page.Page[L]{}, fmt.Errorf("could not fetch leads: %w", err)
+	}
+	return leads, nil
+}
+
 type Storage[Q Quote] interface {
 	create(context.Context, Q) error
 	update(context.Context, Q) error
 	quote(ctx context.Context, query Query, orgID string) (Q, error)
+	quotes(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[Q], error)
+	quotesWithStatus(ctx context.Context, afterID string, limit int, statuses ...Status) ([]Q, error)
 }
 
 type storage[Q Quote] struct {
@@ -105,6 +120,10 @@ func (s storage[Q]) quote(ctx context.Context, opts Query, orgID string) (Q, err
 		query = query.Where("consent_id = ?", opts.ConsentID)
 	}
 
+	if opts.ForUpdate {
+		query = query.Clauses(clause.Locking{Strength: "UPDATE"})
+	}
+
 	quote := new(Q)
 	if err := query.First(quote).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -114,3 +133,130 @@ func (s storage[Q]) quote(ctx context.Context, opts Query, orgID string) (Q, err
 	}
 	return *quote, nil
 }
+
+//nolint:unused
+func (s storage[Q]) quotes(ctx context.Context, filter Filter, orgID string, pag page.Pagination) (page.Page[Q], error) {
+	query := s.db.WithContext(ctx).Model(new(Q)).Where("org_id = ?", orgID).Order("created_at DESC")
+	quotes, err := page.Paginate[Q](applyFilter(query, filter), pag)
+	if err != nil {
+		return page.Page[Q]{}, fmt.Errorf("could not fetch quotes: %w", err)
+	}
+	return quotes, nil
+}
+
+// quotesWithStatus returns up to limit quotes of all organizations in one of
+// the statuses informed, ordered by ID and starting after afterID. Paging by ID
+// keeps the pages stable while the quotes already returned change status.
+//
+//nolint:unused
+func (s storage[Q]) quotesWithStatus(ctx context.Context, afterID string, limit int, statuses ...Status) ([]Q, error) {
+	query := s.db.WithContext(ctx).Model(new(Q)).Where("status IN ?", statuses)
+	if afterID != "" {
+		query = query.Where("id > ?", afterID)
+	}
+
+	var quotes []Q
+	if err := query.Order("id").Limit(limit).Find(&quotes).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch quotes: %w", err)
+	}
+	return quotes, nil
+}
+
+// applyFilter restricts the query for leads or quotes. Both keep the customer
+// in the "customer" field of their data.
+func applyFilter(query *gorm.DB, filter Filter) *gorm.DB {
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+
+	if filter.From != nil {
+		query = query.Where("created_at >= ?", *filter.From)
+	}
+
+	if filter.To != nil {
+		query = query.Where("created_at <= ?", *filter.To)
+	}
+
+	if filter.Document != "" {
+		query = query.Where(
+			"data->'customer'->'personal'->'identification'->>'cpfNumber' = ? OR data->'customer'->'business'->'identification'->'companyInfo'->>'cnpj' = ?",
+			filter.Document, filter.Document,
+		)
+	}
+
+	return query
+}
+
+type StatusHistoryStorage interface {
+	create(context.Context, *StatusHistory) error
+	history(ctx context.Context, quoteID, orgID string) ([]*StatusHistory, error)
+}
+
+type statusHistoryStorage struct {
+	db *gorm.DB
+}
+
+func (s statusHistoryStorage) create(ctx context.Context, h *StatusHistory) error {
+	if err := s.db.WithContext(ctx).Create(h).Error; err != nil {
+		return fmt.Errorf("could not create quote status history: %w", err)
+	}
+	return nil
+}
+
+func (s statusHistoryStorage) history(ctx context.Context, quoteID, orgID string) ([]*StatusHistory, error) {
+	var history []*StatusHistory
+	if err := s.db.WithContext(ctx).
+		Where("quote_id = ? AND org_id = ?", quoteID, orgID).
+		Order("created_at ASC").
+		Find(&history).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch quote status history: %w", err)
+	}
+	return history, nil
+}
+
+type ScenarioStorage interface {
+	save(context.Context, *Scenario) error
+	scenarios(ctx context.Context, orgID string) ([]*Scenario, error)
+	delete(ctx context.Context, name, orgID string) error
+}
+
+type scenarioStorage struct {
+	db *gorm.DB
+}
+
+// save upserts the scenario and loads it back as stored, so an update keeps
+// the ID and creation time of the existing scenario.
+func (s scenarioStorage) save(ctx context.Context, scenario *Scenario) error {
+	err := s.db.WithContext(ctx).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "org_id"}, {Name: "name"}},
+			DoUpdates: clause.AssignmentColumns([]string{"priority", "rule", "outcome", "updated_at"}),
+		}, clause.Returning{}).
+		Create(scenario).Error
+	if err != nil {
+		return fmt.Errorf("could not save quote scenario: %w", err)
+	}
+	return nil
+}
+
+func (s scenarioStorage) scenarios(ctx context.Context, orgID string) ([]*Scenario, error) {
+	var scenarios []*Scenario
+	if err := s.db.WithContext(ctx).
+		Where("org_id = ?", orgID).
+		Order("priority ASC, created_at ASC").
+		Find(&scenarios).Error; err != nil {
+		return nil, fmt.Errorf("could not fetch quote scenarios: %w", err)
+	}
+	return scenarios, nil
+}
+
+func (s scenarioStorage) delete(ctx context.Context, name, orgID string) error {
+	tx := s.db.WithContext(ctx).Where("name = ? AND org_id = ?", name, orgID).Delete(&Scenario{})
+	if err := tx.Error; err != nil {
+		return fmt.Errorf("could not delete quote scenario: %w", err)
+	}
+	if tx.RowsAffected == 0 {
+		return ErrScenarioNotFound
+	}
+	return nil
+}
@@ -0,0 +1,286 @@
+// Package recording captures the traffic served as request and response pairs
+// so bug reports can be reproduced by replaying them against another server.
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/luikyv/mock-insurer/internal/timeutil"
+)
+
+const (
+	// MaxBodyBytes limits the size of the bodies recorded.
+	MaxBodyBytes = 64 * 1024
+	redacted     = "[REDACTED]"
+)
+
+var (
+	// sensitiveHeaders carry credentials and are never recorded as sent.
+	sensitiveHeaders = []string{
+		"Authorization",
+		"Cookie",
+		"Dpop",
+		"Proxy-Authorization",
+		"Set-Cookie",
+	}
+	// sensitiveFields are the JSON fields, form fields and query parameters
+	// redacted from the recordings.
+	sensitiveFields = []string{
+		"access_token",
+		"client_assertion",
+		"client_secret",
+		"id_token",
+		"password",
+		"refresh_token",
+		"registration_access_token",
+	}
+	// oauthFields are only redacted from the form fields and query parameters
+	// of the OAuth endpoints and from the redirects to the clients, since the
+	// Open Insurance APIs use the same names for data, e.g. the code of a
+	// coverage or of an error. The otp is the one-time password the user types
+	// in the authorization pages.
+	oauthFields = []string{
+		"code",
+		"code_verifier",
+		"otp",
+	}
+	// oauthPaths are the OAuth endpoints, including the pages of the
+	// authorization flow under /authorize.
+	oauthPaths = []string{
+		"/authorize",
+		"/par",
+		"/token",
+	}
+)
+
+// Exchange is a request served along with its response.
+type Exchange struct {
+	InteractionID string            `json:"interactionId,omitempty"`
+	StartedAt     timeutil.DateTime `json:"startedAt"`
+	// Duration is the time taken to respond in milliseconds.
+	Duration int64    `json:"duration"`
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+type Request struct {
+	Method string      `json:"method"`
+	Host   string      `json:"host"`
+	Path   string      `json:"path"`
+	Query  string      `json:"query,omitempty"`
+	Header http.Header `json:"header"`
+	Body   string      `json:"body,omitempty"`
+	// BodyTruncated is true when the body exceeded MaxBodyBytes.
+	BodyTruncated bool `json:"bodyTruncated,omitempty"`
+}
+
+type Response struct {
+	StatusCode    int         `json:"statusCode"`
+	Header        http.Header `json:"header"`
+	Body          string      `json:"body,omitempty"`
+	BodyTruncated bool        `json:"bodyTruncated,omitempty"`
+}
+
+// Sanitize redacts the credentials in headers, query parameters and bodies of
+// the exchange.
+func (e *Exchange) Sanitize() {
+	fields := sensitiveFields
+	if isOAuthPath(e.Request.Path) {
+		fields = slices.Concat(sensitiveFields, oauthFields)
+	}
+
+	e.Request.Header = sanitizeHeader(e.Request.Header)
+	e.Request.Query = sanitizeForm(e.Request.Query, fields)
+	e.Request.Body = sanitizeBody(e.Request.Header.Get("Content-Type"), e.Request.Body, fields)
+	e.Response.Header = sanitizeHeader(e.Response.Header)
+	e.Response.Body = sanitizeBody(e.Response.Header.Get("Content-Type"), e.Response.Body, sensitiveFields)
+}
+
+func isOAuthPath(path string) bool {
+	for _, p := range oauthPaths {
+		if path == p || strings.HasPrefix(path, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func sanitizeHeader(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := header[name]; ok {
+			header[name] = []string{redacted}
+		}
+	}
+	if location := header.Get("Location"); location != "" {
+		header.Set("Location", sanitizeLocation(location))
+	}
+	return header
+}
+
+// sanitizeLocation redacts the authorization code from the redirects to the
+// clients, where it is sent either in the query or in the fragment.
+func sanitizeLocation(location string) string {
+	u, err := url.Parse(location)
+	if err != nil {
+		return location
+	}
+
+	fields := slices.Concat(sensitiveFields, oauthFields)
+	u.RawQuery = sanitizeForm(u.RawQuery, fields)
+	if u.Fragment != "" {
+		u.Fragment = sanitizeForm(u.Fragment, fields)
+		u.RawFragment = ""
+	}
+	return u.String()
+}
+
+func sanitizeBody(contentType, body string, fields []string) string {
+	if body == "" {
+		return body
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		return sanitizeForm(body, fields)
+	case strings.Contains(contentType, "json"):
+		var v any
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return body
+		}
+		sanitized, err := json.Marshal(sanitizeJSON(v))
+		if err != nil {
+			return body
+		}
+		return string(sanitized)
+	default:
+		return body
+	}
+}
+
+func sanitizeForm(form string, fields []string) string {
+	if form == "" {
+		return form
+	}
+
+	values, err := url.ParseQuery(form)
+	if err != nil {
+		return form
+	}
+	for _, field := range fields {
+		if values.Has(field) {
+			values.Set(field, redacted)
+		}
+	}
+	return values.Encode()
+}
+
+func sanitizeJSON(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if slices.Contains(sensitiveFields, strings.ToLower(key)) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = sanitizeJSON(value)
+		}
+		return v
+	case []any:
+		for i, value := range v {
+			v[i] = sanitizeJSON(value)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// Diff compares a recorded response with the one obtained when replaying it.
+// It returns a line per difference found in the status code, the content type
+// or the body. JSON bodies are compared field by field, skipping the fields
+// named in ignoredFields, e.g. requestDateTime, which are expected to change.
+func Diff(recorded, replayed Response, ignoredFields []string) []string {
+	var diffs []string
+	if recorded.StatusCode != replayed.StatusCode {
+		diffs = append(diffs, fmt.Sprintf("status: %d != %d", recorded.StatusCode, replayed.StatusCode))
+	}
+
+	recordedType, replayedType := recorded.Header.Get("Content-Type"), replayed.Header.Get("Content-Type")
+	if recordedType != replayedType {
+		diffs = append(diffs, fmt.Sprintf("content type: %q != %q", recordedType, replayedType))
+	}
+
+	var recordedBody, replayedBody any
+	if json.Unmarshal([]byte(recorded.Body), &recordedBody) != nil || json.Unmarshal([]byte(replayed.Body), &replayedBody) != nil {
+		if recorded.Body != replayed.Body {
+			diffs = append(diffs, "body: the bodies are different")
+		}
+		return diffs
+	}
+
+	return append(diffs, diffJSON("body", recordedBody, replayedBody, ignoredFields)...)
+}
+
+func diffJSON(path string, recorded, replayed any, ignoredFields []string) []string {
+	switch recorded := recorded.(type) {
+	case map[string]any:
+		replayed, ok := replayed.(map[string]any)
+		if !ok {
+			break
+		}
+
+		var diffs []string
+		keys := make([]string, 0, len(recorded)+len(replayed))
+		for key := range recorded {
+			keys = append(keys, key)
+		}
+		for key := range replayed {
+			if _, ok := recorded[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			if slices.Contains(ignoredFields, key) {
+				continue
+			}
+			diffs = append(diffs, diffJSON(path+"."+key, recorded[key], replayed[key], ignoredFields)...)
+		}
+		return diffs
+	case []any:
+		replayed, ok := replayed.([]any)
+		if !ok {
+			break
+		}
+		if len(recorded) != len(replayed) {
+			return []string{fmt.Sprintf("%s: %d items != %d items", path, len(recorded), len(replayed))}
+		}
+
+		var diffs []string
+		for i := range recorded {
+			diffs = append(diffs, diffJSON(fmt.Sprintf("%s[%d]", path, i), recorded[i], replayed[i], ignoredFields)...)
+		}
+		return diffs
+	}
+
+	if reflect.DeepEqual(recorded, replayed) {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %s != %s", path, jsonString(recorded), jsonString(replayed))}
+}
+
+func jsonString(v any) string {
+	if v == nil {
+		return "<missing>"
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
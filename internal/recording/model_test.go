@@ -0,0 +1,145 @@
+package recording_test
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/luikyv/mock-insurer/internal/recording"
+)
+
+func TestSanitize(t *testing.T) {
+	// Given.
+	exchange := recording.Exchange{
+		Request: recording.Request{
+			Path: "/token",
+			Header: http.Header{
+				"Authorization": {"Bearer token"},
+				"Content-Type":  {"application/x-www-form-urlencoded"},
+			},
+			Query: "code=abc&state=xyz",
+			Body:  "grant_type=authorization_code&code=abc&code_verifier=v",
+		},
+		Response: recording.Response{
+			Header: http.Header{
+				"Content-Type": {"application/json"},
+				"Location":     {"https://client.local/callback?code=abc&state=xyz"},
+			},
+			Body: `{"access_token":"at","token_type":"Bearer","data":[{"refresh_token":"rt"}]}`,
+		},
+	}
+
+	// When.
+	exchange.Sanitize()
+
+	// Then.
+	if got := exchange.Request.Header.Get("Authorization"); got != "[REDACTED]" {
+		t.Errorf("got authorization %s, want it redacted", got)
+	}
+	if got := exchange.Request.Query; strings.Contains(got, "abc") || !strings.Contains(got, "state=xyz") {
+		t.Errorf("got query %s, want only the code redacted", got)
+	}
+	if got := exchange.Request.Body; strings.Contains(got, "abc") || strings.Contains(got, "code_verifier=v") || !strings.Contains(got, "grant_type=authorization_code") {
+		t.Errorf("got request body %s, want only the code and verifier redacted", got)
+	}
+	if got := exchange.Response.Header.Get("Location"); strings.Contains(got, "abc") || !strings.Contains(got, "state=xyz") {
+		t.Errorf("got location %s, want only the code redacted", got)
+	}
+	if got := exchange.Response.Body; strings.Contains(got, `"at"`) || strings.Contains(got, `"rt"`) || !strings.Contains(got, `"token_type":"Bearer"`) {
+		t.Errorf("got response body %s, want only the tokens redacted", got)
+	}
+}
+
+func TestSanitize_OTP(t *testing.T) {
+	// Given.
+	exchange := recording.Exchange{
+		Request: recording.Request{
+			Path:   "/authorize/7b0f3c",
+			Header: http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
+			Body:   "otp=123456&login=true",
+		},
+	}
+
+	// When.
+	exchange.Sanitize()
+
+	// Then.
+	if got := exchange.Request.Body; strings.Contains(got, "123456") || !strings.Contains(got, "login=true") {
+		t.Errorf("got request body %s, want only the otp redacted", got)
+	}
+}
+
+func TestSanitize_DataCodes(t *testing.T) {
+	// Given.
+	exchange := recording.Exchange{
+		Request: recording.Request{
+			Path:   "/open-insurance/quote-auto/v1/request",
+			Header: http.Header{"Content-Type": {"application/json"}},
+			Query:  "code=AUTO-001",
+			Body:   `{"data":{"quoteData":{"coverages":[{"code":"CASCO_COMPREENSIVA"}]}}}`,
+		},
+		Response: recording.Response{
+			Header: http.Header{"Content-Type": {"application/json"}},
+			Body:   `{"errors":[{"code":"NAO_INFORMADO","title":"t","detail":"d"}]}`,
+		},
+	}
+
+	// When.
+	exchange.Sanitize()
+
+	// Then.
+	if got := exchange.Request.Query; got != "code=AUTO-001" {
+		t.Errorf("got query %s, want it unchanged", got)
+	}
+	if got := exchange.Request.Body; !strings.Contains(got, `"code":"CASCO_COMPREENSIVA"`) {
+		t.Errorf("got request body %s, want the coverage code kept", got)
+	}
+	if got := exchange.Response.Body; !strings.Contains(got, `"code":"NAO_INFORMADO"`) {
+		t.Errorf("got response body %s, want the error code kept", got)
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		recorded recording.Response
+		replayed recording.Response
+		want     []string
+	}{
+		{
+			name:     "same response",
+			recorded: recording.Response{StatusCode: 200, Body: `{"data":{"status":"AUTHORISED"},"meta":{"requestDateTime":"a"}}`},
+			replayed: recording.Response{StatusCode: 200, Body: `{"meta":{"requestDateTime":"b"},"data":{"status":"AUTHORISED"}}`},
+		},
+		{
+			name:     "different fields",
+			recorded: recording.Response{StatusCode: 200, Body: `{"data":{"status":"AUTHORISED","items":[1,2]}}`},
+			replayed: recording.Response{StatusCode: 422, Body: `{"data":{"status":"REJECTED","items":[1],"extra":true}}`},
+			want: []string{
+				"status: 200 != 422",
+				"body.data.extra: <missing> != true",
+				"body.data.items: 2 items != 1 items",
+				`body.data.status: "AUTHORISED" != "REJECTED"`,
+			},
+		},
+		{
+			name:     "different text bodies",
+			recorded: recording.Response{StatusCode: 200, Body: "eyJ.a.b"},
+			replayed: recording.Response{StatusCode: 200, Body: "eyJ.c.d"},
+			want:     []string{"body: the bodies are different"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// When.
+			got := recording.Diff(tt.recorded, tt.replayed, []string{"requestDateTime"})
+
+			// Then.
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+// Recorder appends exchanges to a JSON Lines file. It is safe for concurrent
+// use.
+type Recorder struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+func NewRecorder(path string) (*Recorder, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the recording file: %w", err)
+	}
+	return &Recorder{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// Record sanitizes the exchange and appends it to the recording.
+func (r *Recorder) Record(e *Exchange) error {
+	e.Sanitize()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.enc.Encode(e); err != nil {
+		return fmt.Errorf("could not record exchange: %w", err)
+	}
+	return nil
+}
+
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.file.Close()
+}
+
+// Read loads the exchanges of a recording in the order they were recorded.
+func Read(path string) ([]*Exchange, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open the recording file: %w", err)
+	}
+	defer file.Close()
+
+	var exchanges []*Exchange
+	dec := json.NewDecoder(file)
+	for dec.More() {
+		var e Exchange
+		if err := dec.Decode(&e); err != nil {
+			return nil, fmt.Errorf("could not decode exchange %d: %w", len(exchanges)+1, err)
+		}
+		exchanges = append(exchanges, &e)
+	}
+	return exchanges, nil
+}
@@ -8,4 +8,6 @@ var (
 	ErrUserDoesNotOwnBusiness = errors.New("user is not associated with the business")
 	ErrInvalidOrgID           = errors.New("invalid org id")
 	ErrBusinessHasNoCNPJ      = errors.New("business has no cnpj")
+	ErrInvalidCredentials     = errors.New("invalid credentials")
+	ErrLocked                 = errors.New("user is locked")
 )
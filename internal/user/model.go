@@ -1,11 +1,18 @@
 package user
 
 import (
+	"fmt"
+
 	"github.com/google/uuid"
 	"github.com/luikyv/mock-insurer/internal/timeutil"
+	"golang.org/x/crypto/bcrypt"
 	"gorm.io/gorm"
 )
 
+// MaxFailedLoginAttempts is the number of consecutive failed logins after
+// which a user is locked.
+const MaxFailedLoginAttempts = 5
+
 type User struct {
 	ID          uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
 	Username    string
@@ -13,6 +20,11 @@ type User struct {
 	CPF         string
 	CNPJ        *string
 	Description *string
+	// PasswordHash is the bcrypt hash of the user's password.
+	PasswordHash        string
+	FailedLoginAttempts int
+	// LockedAt is set when the user is locked after too many failed logins.
+	LockedAt *timeutil.DateTime
 
 	OrgID     string
 	CrossOrg  bool
@@ -31,6 +43,24 @@ func (u *User) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// SetPassword replaces the password of the user.
+func (u *User) SetPassword(password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("could not hash the password: %w", err)
+	}
+	u.PasswordHash = string(hash)
+	return nil
+}
+
+func (u User) IsPasswordCorrect(password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(u.PasswordHash), []byte(password)) == nil
+}
+
+func (u User) IsLocked() bool {
+	return u.LockedAt != nil
+}
+
 type Query struct {
 	ID       string
 	Username string
@@ -84,6 +84,76 @@ func (s Service) User(ctx context.Context, query Query, orgID string) (*User, er
 	return u, nil
 }
 
+// Authenticate checks the password of the user with the username informed.
+// Users are locked after MaxFailedLoginAttempts consecutive failures, and
+// locked users are refused even if the password is correct.
+func (s Service) Authenticate(ctx context.Context, username, password, orgID string) (*User, error) {
+	u, err := s.User(ctx, Query{Username: username}, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if u.IsLocked() {
+		return nil, ErrLocked
+	}
+
+	if !u.IsPasswordCorrect(password) {
+		now := timeutil.DateTimeNow()
+		if err := s.db.WithContext(ctx).
+			Model(&User{}).
+			Where("id = ?", u.ID).
+			Updates(map[string]any{
+				"failed_login_attempts": gorm.Expr("failed_login_attempts + 1"),
+				"locked_at":             gorm.Expr("CASE WHEN failed_login_attempts + 1 >= ? THEN ?::timestamptz ELSE locked_at END", MaxFailedLoginAttempts, now),
+				"updated_at":            now,
+			}).Error; err != nil {
+			return nil, fmt.Errorf("could not record the failed login: %w", err)
+		}
+
+		if u.FailedLoginAttempts+1 >= MaxFailedLoginAttempts {
+			return nil, ErrLocked
+		}
+		return nil, ErrInvalidCredentials
+	}
+
+	if u.FailedLoginAttempts != 0 {
+		if err := s.resetFailedLogins(ctx, u.ID); err != nil {
+			return nil, err
+		}
+		u.FailedLoginAttempts = 0
+	}
+	return u, nil
+}
+
+// Unlock allows a locked user to log in again.
+func (s Service) Unlock(ctx context.Context, id, orgID string) (*User, error) {
+	u, err := s.User(ctx, Query{ID: id}, orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.resetFailedLogins(ctx, u.ID); err != nil {
+		return nil, err
+	}
+	u.FailedLoginAttempts = 0
+	u.LockedAt = nil
+	return u, nil
+}
+
+func (s Service) resetFailedLogins(ctx context.Context, id uuid.UUID) error {
+	if err := s.db.WithContext(ctx).
+		Model(&User{}).
+		Where("id = ?", id).
+		Updates(map[string]any{
+			"failed_login_attempts": 0,
+			"locked_at":             nil,
+			"updated_at":            timeutil.DateTimeNow(),
+		}).Error; err != nil {
+		return fmt.Errorf("could not reset the failed logins: %w", err)
+	}
+	return nil
+}
+
 func (s Service) Users(ctx context.Context, orgID string, pag page.Pagination) (page.Page[*User], error) {
 	query := s.db.WithContext(ctx).
 		Model(&User{}).
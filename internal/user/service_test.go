@@ -522,6 +522,106 @@ func TestBindUserToBusiness(t *testing.T) {
 	})
 }
 
+func TestAuthenticate(t *testing.T) {
+	// Given.
+	service := setup(t)
+
+	user := &User{
+		Username: "login@example.com",
+		Name:     "Login User",
+		CPF:      "77777777777",
+		OrgID:    testutil.OrgID,
+	}
+	if err := user.SetPassword("correct-password"); err != nil {
+		t.Fatalf("failed to set password: %v", err)
+	}
+	err := service.Create(context.Background(), user)
+	if err != nil {
+		t.Fatalf("failed to create user: %v", err)
+	}
+
+	t.Run("should authenticate user with the correct password", func(t *testing.T) {
+		// When.
+		result, err := service.Authenticate(context.Background(), user.Username, "correct-password", testutil.OrgID)
+
+		// Then.
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.ID != user.ID {
+			t.Errorf("got %s, want %s", result.ID, user.ID)
+		}
+	})
+
+	t.Run("should reset failed attempts after a successful login", func(t *testing.T) {
+		// Given.
+		_, _ = service.Authenticate(context.Background(), user.Username, "wrong-password", testutil.OrgID)
+
+		// When.
+		_, err := service.Authenticate(context.Background(), user.Username, "correct-password", testutil.OrgID)
+
+		// Then.
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		result, err := service.User(context.Background(), Query{ID: user.ID.String()}, testutil.OrgID)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if result.FailedLoginAttempts != 0 {
+			t.Errorf("got %d failed attempts, want 0", result.FailedLoginAttempts)
+		}
+	})
+
+	t.Run("should lock user after too many failed attempts", func(t *testing.T) {
+		// Given.
+		for i := 1; i < MaxFailedLoginAttempts; i++ {
+			_, err := service.Authenticate(context.Background(), user.Username, "wrong-password", testutil.OrgID)
+			if !errors.Is(err, ErrInvalidCredentials) {
+				t.Fatalf("got %v, want ErrInvalidCredentials", err)
+			}
+		}
+
+		// When.
+		_, err := service.Authenticate(context.Background(), user.Username, "wrong-password", testutil.OrgID)
+
+		// Then.
+		if !errors.Is(err, ErrLocked) {
+			t.Errorf("got %v, want ErrLocked", err)
+		}
+
+		_, err = service.Authenticate(context.Background(), user.Username, "correct-password", testutil.OrgID)
+		if !errors.Is(err, ErrLocked) {
+			t.Errorf("got %v, want ErrLocked for the correct password", err)
+		}
+	})
+
+	t.Run("should authenticate user again after unlocking", func(t *testing.T) {
+		// Given.
+		if _, err := service.Unlock(context.Background(), user.ID.String(), testutil.OrgID); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		// When.
+		_, err := service.Authenticate(context.Background(), user.Username, "correct-password", testutil.OrgID)
+
+		// Then.
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("should return error when user doesn't exist", func(t *testing.T) {
+		// When.
+		_, err := service.Authenticate(context.Background(), "unknown@example.com", "correct-password", testutil.OrgID)
+
+		// Then.
+		if !errors.Is(err, ErrNotFound) {
+			t.Errorf("got %v, want ErrNotFound", err)
+		}
+	})
+}
+
 func setup(t *testing.T) Service {
 	db := testutil.NewDB(t)
 	return NewService(db)
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"sync"
+	"sync/atomic"
 
 	"github.com/google/uuid"
 	"github.com/luikyv/mock-insurer/internal/client"
@@ -18,6 +20,43 @@ const (
 	consentPath                = "/open-insurance/webhook/v1/consents/%s/consents/%s"
 )
 
+var (
+	// pending counts the notifications being delivered.
+	pending atomic.Int64
+	// deliveries waits for the notifications being delivered when the
+	// delivery is stopped.
+	deliveries sync.WaitGroup
+	// stopped is guarded by mu so no delivery starts while Stop waits.
+	stopped bool
+	mu      sync.Mutex
+)
+
+// Pending returns the number of notifications being delivered.
+func Pending() int64 {
+	return pending.Load()
+}
+
+// Stop prevents new notifications from being sent and waits for the ones
+// being delivered.
+func Stop(ctx context.Context) error {
+	mu.Lock()
+	stopped = true
+	mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		deliveries.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("could not stop webhook delivery: %w", ctx.Err())
+	}
+}
+
 type Service struct {
 	clientService client.Service
 	httpClient    *http.Client
@@ -35,6 +74,13 @@ func (s Service) NotifyConsent(ctx context.Context, clientID, id, version string
 }
 
 func (s Service) notify(ctx context.Context, clientID, path string) {
+	if !startDelivery() {
+		slog.InfoContext(ctx, "webhook delivery is stopped, skipping notification")
+		return
+	}
+	defer deliveries.Done()
+	defer pending.Add(-1)
+
 	client, err := s.clientService.Client(ctx, clientID)
 	if err != nil {
 		slog.ErrorContext(ctx, "failed to get client", "error", err)
@@ -82,6 +128,19 @@ func (s Service) notify(ctx context.Context, clientID, path string) {
 	slog.InfoContext(ctx, "client was notified", "status", resp.StatusCode)
 }
 
+// startDelivery registers a new delivery unless the delivery is stopped.
+func startDelivery() bool {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if stopped {
+		return false
+	}
+	pending.Add(1)
+	deliveries.Add(1)
+	return true
+}
+
 type payload struct {
 	Data struct {
 		Timestamp timeutil.DateTime `json:"timestamp"`